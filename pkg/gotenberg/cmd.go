@@ -56,6 +56,12 @@ func CommandContext(ctx context.Context, logger *zap.Logger, binPath string, arg
 	}, nil
 }
 
+// SetStdout sets the writer of the standard output of the command, which
+// otherwise goes to the logs. It must be called before starting the command.
+func (cmd *Cmd) SetStdout(stdout io.Writer) {
+	cmd.process.Stdout = stdout
+}
+
 // Start starts the command but does not wait for its completion.
 func (cmd *Cmd) Start() error {
 	err := cmd.pipeOutput()
@@ -140,11 +146,6 @@ func (cmd *Cmd) pipeOutput() error {
 		return nil
 	}
 
-	stdout, err := cmd.process.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("pipe unix process stdout: %w", err)
-	}
-
 	stderr, err := cmd.process.StderrPipe()
 	if err != nil {
 		return fmt.Errorf("unix process sdterr: %w", err)
@@ -177,7 +178,16 @@ func (cmd *Cmd) pipeOutput() error {
 		}
 	}
 
-	go logCommandOutput(cmd.logger.Named("stdout"), stdout)
+	// The standard output may already go somewhere else.
+	if cmd.process.Stdout == nil {
+		stdout, err := cmd.process.StdoutPipe()
+		if err != nil {
+			return fmt.Errorf("pipe unix process stdout: %w", err)
+		}
+
+		go logCommandOutput(cmd.logger.Named("stdout"), stdout)
+	}
+
 	go logCommandOutput(cmd.logger.Named("stderr"), stderr)
 
 	return nil
@@ -46,14 +46,39 @@ func (mod *DebuggableMock) Debug() map[string]interface{} {
 //
 //nolint:dupl
 type PdfEngineMock struct {
-	MergeMock         func(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error
-	SplitMock         func(ctx context.Context, logger *zap.Logger, mode SplitMode, inputPath, outputDirPath string) ([]string, error)
-	FlattenMock       func(ctx context.Context, logger *zap.Logger, inputPath string) error
-	ConvertMock       func(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath, outputPath string) error
-	ReadMetadataMock  func(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error)
-	WriteMetadataMock func(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error
-	EncryptMock       func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error
-	EmbedFilesMock    func(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error
+	MergeMock              func(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error
+	SplitMock              func(ctx context.Context, logger *zap.Logger, mode SplitMode, inputPath, outputDirPath string) ([]string, error)
+	FlattenMock            func(ctx context.Context, logger *zap.Logger, inputPath string) error
+	ConvertMock            func(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath, outputPath string) error
+	ReadMetadataMock       func(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error)
+	WriteMetadataMock      func(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error
+	EncryptMock            func(ctx context.Context, logger *zap.Logger, options EncryptOptions, inputPath string) error
+	EmbedFilesMock         func(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error
+	RotateMock             func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error
+	WatermarkMock          func(ctx context.Context, logger *zap.Logger, watermark Watermark, inputPath string) error
+	OverlayMock            func(ctx context.Context, logger *zap.Logger, overlay Overlay, inputPath string) error
+	InfoMock               func(ctx context.Context, logger *zap.Logger, inputPath string) (PdfInfo, error)
+	OptimizeMock           func(ctx context.Context, logger *zap.Logger, options OptimizeOptions, inputPath string) error
+	DecryptMock            func(ctx context.Context, logger *zap.Logger, inputPath, password string) error
+	ReadBookmarksMock      func(ctx context.Context, logger *zap.Logger, inputPath string) ([]Bookmark, error)
+	WriteBookmarksMock     func(ctx context.Context, logger *zap.Logger, bookmarks []Bookmark, inputPath string) error
+	FillFormMock           func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error
+	ReadFormFieldsMock     func(ctx context.Context, logger *zap.Logger, inputPath string) ([]FormField, error)
+	ExtractTextMock        func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]PdfPageText, error)
+	ListEmbedsMock         func(ctx context.Context, logger *zap.Logger, inputPath string) ([]EmbeddedFile, error)
+	ExtractEmbedsMock      func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error)
+	ExtractImagesMock      func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error)
+	SanitizeMock           func(ctx context.Context, logger *zap.Logger, options SanitizeOptions, inputPath string) error
+	ImportImagesMock       func(ctx context.Context, logger *zap.Logger, options ImportImagesOptions, inputPaths []string, outputPath string) error
+	RasterizeMock          func(ctx context.Context, logger *zap.Logger, options RasterizeOptions, inputPath, outputDirPath string) ([]string, error)
+	ImposeMock             func(ctx context.Context, logger *zap.Logger, options ImposeOptions, inputPath, outputPath string) error
+	PageEditMock           func(ctx context.Context, logger *zap.Logger, instructions []PageEditInstruction, inputPath string) error
+	SignMock               func(ctx context.Context, logger *zap.Logger, options SignOptions, inputPath string) error
+	VerifySignaturesMock   func(ctx context.Context, logger *zap.Logger, inputPath string) ([]PdfSignature, error)
+	ValidateComplianceMock func(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath string) ([]PdfValidationReport, error)
+	RepairMock             func(ctx context.Context, logger *zap.Logger, inputPath string) error
+	ResizeMock             func(ctx context.Context, logger *zap.Logger, options ResizeOptions, inputPath string) error
+	CropMock               func(ctx context.Context, logger *zap.Logger, options CropOptions, inputPath string) error
 }
 
 func (engine *PdfEngineMock) Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error {
@@ -80,14 +105,123 @@ func (engine *PdfEngineMock) WriteMetadata(ctx context.Context, logger *zap.Logg
 	return engine.WriteMetadataMock(ctx, logger, metadata, inputPath)
 }
 
-func (engine *PdfEngineMock) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
-	return engine.EncryptMock(ctx, logger, inputPath, userPassword, ownerPassword)
+func (engine *PdfEngineMock) Encrypt(ctx context.Context, logger *zap.Logger, options EncryptOptions, inputPath string) error {
+	return engine.EncryptMock(ctx, logger, options, inputPath)
 }
 
 func (engine *PdfEngineMock) EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error {
 	return engine.EmbedFilesMock(ctx, logger, filePaths, inputPath)
 }
 
+func (engine *PdfEngineMock) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return engine.RotateMock(ctx, logger, angle, pages, inputPath)
+}
+
+func (engine *PdfEngineMock) Watermark(ctx context.Context, logger *zap.Logger, watermark Watermark, inputPath string) error {
+	return engine.WatermarkMock(ctx, logger, watermark, inputPath)
+}
+
+func (engine *PdfEngineMock) Overlay(ctx context.Context, logger *zap.Logger, overlay Overlay, inputPath string) error {
+	return engine.OverlayMock(ctx, logger, overlay, inputPath)
+}
+
+func (engine *PdfEngineMock) Info(ctx context.Context, logger *zap.Logger, inputPath string) (PdfInfo, error) {
+	return engine.InfoMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) Optimize(ctx context.Context, logger *zap.Logger, options OptimizeOptions, inputPath string) error {
+	return engine.OptimizeMock(ctx, logger, options, inputPath)
+}
+
+func (engine *PdfEngineMock) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return engine.DecryptMock(ctx, logger, inputPath, password)
+}
+
+func (engine *PdfEngineMock) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]Bookmark, error) {
+	return engine.ReadBookmarksMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []Bookmark, inputPath string) error {
+	return engine.WriteBookmarksMock(ctx, logger, bookmarks, inputPath)
+}
+
+func (engine *PdfEngineMock) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return engine.FillFormMock(ctx, logger, values, inputPath)
+}
+
+func (engine *PdfEngineMock) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]FormField, error) {
+	return engine.ReadFormFieldsMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]PdfPageText, error) {
+	return engine.ExtractTextMock(ctx, logger, pageRanges, inputPath)
+}
+
+func (engine *PdfEngineMock) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]EmbeddedFile, error) {
+	return engine.ListEmbedsMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return engine.ExtractEmbedsMock(ctx, logger, inputPath, outputDirPath)
+}
+
+func (engine *PdfEngineMock) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return engine.ExtractImagesMock(ctx, logger, pages, inputPath, outputDirPath)
+}
+
+func (engine *PdfEngineMock) Sanitize(ctx context.Context, logger *zap.Logger, options SanitizeOptions, inputPath string) error {
+	return engine.SanitizeMock(ctx, logger, options, inputPath)
+}
+
+func (engine *PdfEngineMock) ImportImages(ctx context.Context, logger *zap.Logger, options ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return engine.ImportImagesMock(ctx, logger, options, inputPaths, outputPath)
+}
+
+func (engine *PdfEngineMock) Rasterize(ctx context.Context, logger *zap.Logger, options RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return engine.RasterizeMock(ctx, logger, options, inputPath, outputDirPath)
+}
+
+func (engine *PdfEngineMock) Impose(ctx context.Context, logger *zap.Logger, options ImposeOptions, inputPath, outputPath string) error {
+	return engine.ImposeMock(ctx, logger, options, inputPath, outputPath)
+}
+
+func (engine *PdfEngineMock) PageEdit(ctx context.Context, logger *zap.Logger, instructions []PageEditInstruction, inputPath string) error {
+	return engine.PageEditMock(ctx, logger, instructions, inputPath)
+}
+
+func (engine *PdfEngineMock) Sign(ctx context.Context, logger *zap.Logger, options SignOptions, inputPath string) error {
+	return engine.SignMock(ctx, logger, options, inputPath)
+}
+
+func (engine *PdfEngineMock) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]PdfSignature, error) {
+	return engine.VerifySignaturesMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath string) ([]PdfValidationReport, error) {
+	return engine.ValidateComplianceMock(ctx, logger, formats, inputPath)
+}
+
+func (engine *PdfEngineMock) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return engine.RepairMock(ctx, logger, inputPath)
+}
+
+func (engine *PdfEngineMock) Resize(ctx context.Context, logger *zap.Logger, options ResizeOptions, inputPath string) error {
+	return engine.ResizeMock(ctx, logger, options, inputPath)
+}
+
+func (engine *PdfEngineMock) Crop(ctx context.Context, logger *zap.Logger, options CropOptions, inputPath string) error {
+	return engine.CropMock(ctx, logger, options, inputPath)
+}
+
+// PdfFormatsAdvertiserMock is a mock for the [PdfFormatsAdvertiser] interface.
+type PdfFormatsAdvertiserMock struct {
+	SupportedPdfFormatsMock func() ([]string, bool)
+}
+
+func (advertiser *PdfFormatsAdvertiserMock) SupportedPdfFormats() ([]string, bool) {
+	return advertiser.SupportedPdfFormatsMock()
+}
+
 // PdfEngineProviderMock is a mock for the [PdfEngineProvider] interface.
 type PdfEngineProviderMock struct {
 	PdfEngineMock func() (PdfEngine, error)
@@ -188,14 +322,15 @@ func (rename *PathRenameMock) Rename(oldpath, newpath string) error {
 
 // Interface guards.
 var (
-	_ Module            = (*ModuleMock)(nil)
-	_ Validator         = (*ValidatorMock)(nil)
-	_ PdfEngine         = (*PdfEngineMock)(nil)
-	_ PdfEngineProvider = (*PdfEngineProviderMock)(nil)
-	_ Process           = (*ProcessMock)(nil)
-	_ ProcessSupervisor = (*ProcessSupervisorMock)(nil)
-	_ LoggerProvider    = (*LoggerProviderMock)(nil)
-	_ MetricsProvider   = (*MetricsProviderMock)(nil)
-	_ MkdirAll          = (*MkdirAllMock)(nil)
-	_ PathRename        = (*PathRenameMock)(nil)
+	_ Module               = (*ModuleMock)(nil)
+	_ Validator            = (*ValidatorMock)(nil)
+	_ PdfEngine            = (*PdfEngineMock)(nil)
+	_ PdfFormatsAdvertiser = (*PdfFormatsAdvertiserMock)(nil)
+	_ PdfEngineProvider    = (*PdfEngineProviderMock)(nil)
+	_ Process              = (*ProcessMock)(nil)
+	_ ProcessSupervisor    = (*ProcessSupervisorMock)(nil)
+	_ LoggerProvider       = (*LoggerProviderMock)(nil)
+	_ MetricsProvider      = (*MetricsProviderMock)(nil)
+	_ MkdirAll             = (*MkdirAllMock)(nil)
+	_ PathRename           = (*PathRenameMock)(nil)
 )
@@ -0,0 +1,53 @@
+package gotenberg
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PageRange is a range of pages, numbered from 1. A Last value of 0 means the
+// range runs until the last page.
+type PageRange struct {
+	First int
+	Last  int
+}
+
+// ParsePageRanges parses a comma-separated selection of pages: single pages
+// (e.g., "5"), ranges (e.g., "1-3") and ranges until the last page (e.g.,
+// "8-"). It ignores whitespaces.
+//
+// This syntax is common to every PDF engine, which translates the resulting
+// ranges to the syntax of its underlying tool.
+func ParsePageRanges(selection string) ([]PageRange, error) {
+	selection = strings.Join(strings.Fields(selection), "")
+	if selection == "" {
+		return nil, errors.New("pages are required")
+	}
+
+	var ranges []PageRange
+	for _, value := range strings.Split(selection, ",") {
+		from, to, isRange := strings.Cut(value, "-")
+
+		first, err := strconv.Atoi(from)
+		if err != nil || first < 1 {
+			return nil, fmt.Errorf("invalid page range '%s'", value)
+		}
+
+		last := first
+		if isRange {
+			last = 0
+			if to != "" {
+				last, err = strconv.Atoi(to)
+				if err != nil || last < first {
+					return nil, fmt.Errorf("invalid page range '%s'", value)
+				}
+			}
+		}
+
+		ranges = append(ranges, PageRange{First: first, Last: last})
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,67 @@
+package gotenberg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		selection    string
+		expectRanges []PageRange
+		expectError  bool
+	}{
+		{
+			scenario:     "single page",
+			selection:    "5",
+			expectRanges: []PageRange{{First: 5, Last: 5}},
+		},
+		{
+			scenario:     "ranges",
+			selection:    "1-3, 5, 8-",
+			expectRanges: []PageRange{{First: 1, Last: 3}, {First: 5, Last: 5}, {First: 8, Last: 0}},
+		},
+		{
+			scenario:    "empty selection",
+			selection:   " ",
+			expectError: true,
+		},
+		{
+			scenario:    "page 0",
+			selection:   "0-2",
+			expectError: true,
+		},
+		{
+			scenario:    "reversed range",
+			selection:   "3-1",
+			expectError: true,
+		},
+		{
+			scenario:    "engine specific syntax",
+			selection:   "1-z",
+			expectError: true,
+		},
+		{
+			scenario:    "engine specific keyword",
+			selection:   "end",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ranges, err := ParsePageRanges(tc.selection)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(ranges, tc.expectRanges) {
+				t.Errorf("expected ranges %+v but got: %+v", tc.expectRanges, ranges)
+			}
+		})
+	}
+}
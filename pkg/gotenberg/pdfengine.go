@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -28,6 +29,10 @@ var (
 	// ErrPdfEncryptionNotSupported is returned when encryption
 	// is not supported by the PDF engine.
 	ErrPdfEncryptionNotSupported = errors.New("encryption not supported")
+
+	// ErrPdfInvalidPassword is returned when the Decrypt method of the
+	// PdfEngine interface cannot open a PDF with the given password.
+	ErrPdfInvalidPassword = errors.New("invalid PDF password")
 )
 
 // PdfEngineInvalidArgsError represents an error returned by a PDF engine when
@@ -73,6 +78,82 @@ type SplitMode struct {
 	Unify bool
 }
 
+const (
+	// WatermarkSourceText represents a watermark made of text.
+	WatermarkSourceText string = "text"
+
+	// WatermarkSourceImage represents a watermark made of an image.
+	WatermarkSourceImage string = "image"
+
+	// WatermarkSourcePdf represents a watermark made of the first page of a
+	// PDF.
+	WatermarkSourcePdf string = "pdf"
+)
+
+// Watermark gathers the data required to watermark (or stamp) the pages of a
+// PDF.
+type Watermark struct {
+	// Source is either "text", "image" or "pdf".
+	Source string
+
+	// Expression is the text to apply for the "text" source, or the path of
+	// the image or PDF file for the other sources.
+	Expression string
+
+	// Pages is the page ranges to watermark. If empty, all pages.
+	Pages string
+
+	// Opacity is the opacity of the watermark, from 0 (excluded) to 1. If
+	// zero, the engine default applies.
+	Opacity float64
+
+	// Rotation is the rotation of the watermark in degrees, from -180 to 180.
+	Rotation float64
+
+	// Position is the position of the watermark on the page: "center",
+	// "top-left", "top-center", "top-right", "left", "right", "bottom-left",
+	// "bottom-center" or "bottom-right". If empty, the engine default
+	// applies.
+	Position string
+
+	// Scale is the size of the watermark relative to the page, from 0
+	// (excluded) to 1. If zero, the engine default applies.
+	Scale float64
+
+	// Foreground places the watermark over the page content (a stamp)
+	// instead of behind it.
+	Foreground bool
+}
+
+const (
+	// OverlayModeFirst represents a mode where only the first page of the
+	// overlay PDF is laid on the first page of the target PDF.
+	OverlayModeFirst string = "first"
+
+	// OverlayModeSequence represents a mode where each page of the overlay
+	// PDF is laid on the page of the target PDF with the same number.
+	OverlayModeSequence string = "sequence"
+
+	// OverlayModeRepeat represents a mode where the pages of the overlay PDF
+	// are laid on the pages of the target PDF with the same number, the last
+	// page of the overlay PDF being repeated on the remaining pages.
+	OverlayModeRepeat string = "repeat"
+)
+
+// Overlay gathers the data required to lay the pages of a PDF over or under
+// the pages of another PDF (e.g., a letterhead).
+type Overlay struct {
+	// Path is the path of the PDF whose pages are laid on the target pages.
+	Path string
+
+	// Underlay places the pages under the target page content instead of
+	// over it.
+	Underlay bool
+
+	// Mode is either "first", "sequence" or "repeat".
+	Mode string
+}
+
 const (
 	// PdfA1a represents the PDF/A-1a format.
 	PdfA1a string = "PDF/A-1a"
@@ -97,6 +178,12 @@ const (
 
 	// PdfA3u represents the PDF/A-3u format.
 	PdfA3u string = "PDF/A-3u"
+
+	// PdfA4 represents the PDF/A-4 format.
+	PdfA4 string = "PDF/A-4"
+
+	// PdfA4f represents the PDF/A-4f format.
+	PdfA4f string = "PDF/A-4f"
 )
 
 // PdfFormats specifies the target formats for a PDF conversion.
@@ -109,6 +196,533 @@ type PdfFormats struct {
 	PdfUa bool
 }
 
+const (
+	// EncryptionAlgorithmAes256 represents the AES 256-bit encryption.
+	EncryptionAlgorithmAes256 string = "aes-256"
+
+	// EncryptionAlgorithmAes128 represents the AES 128-bit encryption.
+	EncryptionAlgorithmAes128 string = "aes-128"
+
+	// EncryptionAlgorithmRc4128 represents the RC4 128-bit encryption.
+	EncryptionAlgorithmRc4128 string = "rc4-128"
+
+	// EncryptionAlgorithmRc440 represents the RC4 40-bit encryption.
+	EncryptionAlgorithmRc440 string = "rc4-40"
+)
+
+// EncryptOptions gathers the options for password-protecting a PDF file.
+type EncryptOptions struct {
+	// UserPassword is required to open the document.
+	UserPassword string
+
+	// OwnerPassword provides full access to the document. If empty, it
+	// defaults to the user password.
+	OwnerPassword string
+
+	// Algorithm is either "aes-256", "aes-128", "rc4-128" or "rc4-40". If
+	// empty, the engine uses its default algorithm.
+	Algorithm string
+
+	// Permissions lists what the document allows when opened with the user
+	// password. A zero-valued [PdfPermissions] allows nothing. If nil, the
+	// engine applies its default permissions.
+	Permissions *PdfPermissions
+}
+
+// OptimizeOptions gathers the options for reducing the size of a PDF file.
+type OptimizeOptions struct {
+	// CompressStreams compresses the uncompressed streams and recompresses
+	// the others at the highest compression level.
+	CompressStreams bool
+
+	// ObjectStreams packs the objects into compressed object streams.
+	ObjectStreams bool
+
+	// RemoveDuplicates removes duplicate resources, such as fonts and
+	// images.
+	RemoveDuplicates bool
+
+	// CompressImages recompresses the images with a lossy JPEG compression
+	// when it results in smaller images. It does not downsample the images:
+	// their resolution remains the same.
+	CompressImages bool
+
+	// Linearize optimizes the PDF for fast web view.
+	Linearize bool
+}
+
+// SanitizeOptions gathers the categories of active or hidden content to
+// remove from a PDF file.
+type SanitizeOptions struct {
+	// JavaScript removes the JavaScript actions, the document-level scripts
+	// and the additional actions triggered by events.
+	JavaScript bool
+
+	// LaunchActions removes the actions launching external applications.
+	LaunchActions bool
+
+	// EmbeddedFiles removes the embedded files, the associated files and the
+	// file attachment annotations.
+	EmbeddedFiles bool
+
+	// Xfa removes the XFA forms, keeping the AcroForm fields.
+	Xfa bool
+
+	// Annotations removes all annotations, including the form field widgets
+	// and, as such, the interactive form.
+	Annotations bool
+}
+
+// ImportImagesOptions gathers the options for converting images to PDF.
+type ImportImagesOptions struct {
+	// PageSize is the size of the pages (e.g., "A4" or "Letter"). If empty,
+	// each page has the size of its image.
+	PageSize string
+
+	// Landscape sets the landscape orientation of the pages.
+	Landscape bool
+
+	// Fit scales the images to fit the pages, keeping their aspect ratio.
+	// Otherwise, the images keep their size.
+	Fit bool
+
+	// Center centers the images on the pages. Otherwise, the images are
+	// placed at the top left corner of the pages.
+	Center bool
+
+	// Dpi is the resolution used to compute the size of the images. If zero,
+	// the resolution of the images applies.
+	Dpi int
+}
+
+const (
+	// RasterizeFormatPng represents the PNG image format.
+	RasterizeFormatPng string = "png"
+
+	// RasterizeFormatJpeg represents the JPEG image format.
+	RasterizeFormatJpeg string = "jpeg"
+
+	// RasterizeFormatWebp represents the WebP image format.
+	RasterizeFormatWebp string = "webp"
+)
+
+// RasterizeOptions gathers the options for rendering the pages of a PDF file
+// as images.
+type RasterizeOptions struct {
+	// Format is the image format (e.g., "png").
+	Format string
+
+	// Dpi is the resolution of the images. If zero, the engine default
+	// applies.
+	Dpi int
+
+	// Quality is the compression quality of the JPEG and WebP images, from 1
+	// to 100. If zero, the engine default applies.
+	Quality int
+
+	// PageRanges selects the pages (e.g., "1-3, 5"). If empty, it renders
+	// all pages.
+	PageRanges string
+}
+
+const (
+	// ImposeModeNup represents a mode where several pages are arranged in a
+	// grid on each sheet.
+	ImposeModeNup string = "nup"
+
+	// ImposeModeBooklet represents a mode where the pages are arranged for a
+	// saddle-stitched booklet, i.e., sheets printed on both sides, folded in
+	// the middle and stapled.
+	ImposeModeBooklet string = "booklet"
+)
+
+// ImposeOptions gathers the options for imposing the pages of a PDF file on
+// sheets.
+type ImposeOptions struct {
+	// Mode is either "nup" or "booklet".
+	Mode string
+
+	// PagesPerSheet is the number of pages per sheet (per side of a sheet
+	// for the "booklet" mode).
+	PagesPerSheet int
+
+	// PageSize is the size of the sheets (e.g., "A4" or "Letter"). If empty,
+	// the engine default applies.
+	PageSize string
+
+	// Landscape sets the landscape orientation of the sheets.
+	Landscape bool
+
+	// Orientation is the order in which the pages fill the grid of a sheet:
+	// "right-down", "down-right", "left-down" or "down-left". Only works with
+	// the "nup" mode. If empty, the engine default applies.
+	Orientation string
+
+	// Border draws a border around each page.
+	Border bool
+
+	// Margin is the space around each page, in points.
+	Margin float64
+}
+
+const (
+	// PageEditKeep keeps only the selected pages.
+	PageEditKeep string = "keep"
+
+	// PageEditDelete deletes the selected pages.
+	PageEditDelete string = "delete"
+
+	// PageEditInsertBlank inserts blank pages after a given page.
+	PageEditInsertBlank string = "insert-blank"
+
+	// PageEditMove moves the selected pages after a given page.
+	PageEditMove string = "move"
+
+	// PageEditDuplicate adds copies of each selected page right after it.
+	PageEditDuplicate string = "duplicate"
+)
+
+// PageEditInstruction is an instruction for editing the pages of a PDF file.
+// The page numbers always refer to the pages as they are after the previous
+// instructions.
+type PageEditInstruction struct {
+	// Op is either "keep", "delete", "insert-blank", "move" or "duplicate".
+	Op string `json:"op"`
+
+	// Pages selects the pages (e.g., "1-3, 5"). Not used by the
+	// "insert-blank" operation.
+	Pages string `json:"pages,omitempty"`
+
+	// After is the page after which the blank or moved pages go. Zero means
+	// before the first page.
+	After int `json:"after,omitempty"`
+
+	// Count is the number of blank pages or copies. Zero means one.
+	Count int `json:"count,omitempty"`
+}
+
+// SignOptions gathers the options for digitally signing a PDF file.
+type SignOptions struct {
+	// FieldName is the name of the signature field. The field is created if
+	// it does not exist. If empty, the engine default applies.
+	FieldName string
+
+	// Visible tells whether the signature has an appearance on a page.
+	Visible bool
+
+	// Page is the page of a visible signature, starting from 1.
+	Page int
+
+	// Rect is the rectangle of a visible signature, in points, as the lower
+	// left and the upper right corners (x1, y1, x2, y2).
+	Rect [4]float64
+
+	// Reason is the reason for signing.
+	Reason string
+
+	// Location is the location of the signer.
+	Location string
+
+	// ContactInfo is the contact information of the signer.
+	ContactInfo string
+
+	// TimestampUrl is the URL of an RFC 3161 timestamp authority. If empty,
+	// the signature has no timestamp.
+	TimestampUrl string
+
+	// KeyPaths are the paths to the signing key and its certificates, either
+	// a PKCS#12 bundle or PEM files, uploaded with the request. If empty, the
+	// engine uses its own keys.
+	KeyPaths []string
+
+	// Passphrase unlocks the uploaded signing key.
+	Passphrase string
+}
+
+// PdfSignature describes a digital signature of a PDF file and the result
+// of its verification.
+type PdfSignature struct {
+	// FieldName is the name of the signature field.
+	FieldName string `json:"fieldName"`
+
+	// Certificates is the certificate chain of the signer, from the signer's
+	// certificate up to the root, as far as the signature embeds it.
+	Certificates []PdfCertificate `json:"certificates"`
+
+	// SigningTime is the signing time as reported by the signer, if any.
+	SigningTime *time.Time `json:"signingTime"`
+
+	// Intact tells whether the signed data has not been altered and the
+	// signature is cryptographically sound.
+	Intact bool `json:"intact"`
+
+	// CoversWholeDocument tells whether the byte range of the signature
+	// covers the whole PDF file.
+	CoversWholeDocument bool `json:"coversWholeDocument"`
+
+	// ModifiedAfterSigning tells whether the PDF file has been modified
+	// after signing, through incremental updates.
+	ModifiedAfterSigning bool `json:"modifiedAfterSigning"`
+
+	// Trusted tells whether the certificate chain of the signer leads to a
+	// trusted certificate authority.
+	Trusted bool `json:"trusted"`
+}
+
+// PdfCertificate describes an X.509 certificate embedded in a PDF file.
+type PdfCertificate struct {
+	// Subject is the distinguished name of the certificate holder.
+	Subject string `json:"subject"`
+
+	// Issuer is the distinguished name of the certificate authority.
+	Issuer string `json:"issuer"`
+
+	// SerialNumber is the serial number, in hexadecimal.
+	SerialNumber string `json:"serialNumber"`
+
+	// NotBefore is the start of the validity period.
+	NotBefore time.Time `json:"notBefore"`
+
+	// NotAfter is the end of the validity period.
+	NotAfter time.Time `json:"notAfter"`
+}
+
+// PdfValidationReport is the result of the validation of a PDF file against
+// a conformance profile (e.g., PDF/A-2b or PDF/UA-1).
+type PdfValidationReport struct {
+	// Profile is the name of the conformance profile.
+	Profile string `json:"profile"`
+
+	// Compliant tells whether the PDF file conforms to the profile.
+	Compliant bool `json:"compliant"`
+
+	// Violations lists the rules the PDF file does not follow.
+	Violations []PdfRuleViolation `json:"violations"`
+}
+
+// PdfRuleViolation describes a rule of a conformance profile a PDF file does
+// not follow.
+type PdfRuleViolation struct {
+	// Specification is the standard defining the rule (e.g., "ISO
+	// 19005-2:2011").
+	Specification string `json:"specification"`
+
+	// Clause is the clause of the specification defining the rule.
+	Clause string `json:"clause"`
+
+	// TestNumber identifies the rule within its clause.
+	TestNumber int `json:"testNumber"`
+
+	// Description explains the rule.
+	Description string `json:"description"`
+
+	// FailedChecks is the number of objects of the PDF file which do not
+	// follow the rule.
+	FailedChecks int `json:"failedChecks"`
+}
+
+// ResizeOptions specifies how to resize the pages of a PDF file, either to a
+// paper size or by a scale factor.
+type ResizeOptions struct {
+	// PaperWidth is the target paper width in inches.
+	PaperWidth float64
+
+	// PaperHeight is the target paper height in inches.
+	PaperHeight float64
+
+	// Scale is the scale factor. It applies if there is no target paper
+	// size.
+	Scale float64
+
+	// Pages is the page ranges to resize. If empty, all pages.
+	Pages string
+}
+
+// BoxMargins are the margins, in inches, of a page box from its parent box:
+// the media box for the crop box, the crop box for the other ones.
+type BoxMargins struct {
+	Top    float64
+	Right  float64
+	Bottom float64
+	Left   float64
+}
+
+// CropOptions specifies the page boxes to set on the pages of a PDF file. A
+// nil page box is left as is.
+type CropOptions struct {
+	// CropBox is the region to which the content of a page is clipped when
+	// displayed or printed.
+	CropBox *BoxMargins
+
+	// TrimBox is the intended dimensions of a page after trimming.
+	TrimBox *BoxMargins
+
+	// BleedBox is the region to which the content of a page is clipped in
+	// a production environment.
+	BleedBox *BoxMargins
+
+	// Pages is the page ranges to crop. If empty, all pages.
+	Pages string
+}
+
+// Bookmark represents an entry of the outline of a PDF file.
+type Bookmark struct {
+	// Title is the text displayed for the entry.
+	Title string `json:"title"`
+
+	// Page is the target page number, starting from 1.
+	Page int `json:"page"`
+
+	// Children are the nested entries.
+	Children []Bookmark `json:"children,omitempty"`
+}
+
+const (
+	// FormFieldTypeText represents a text field.
+	FormFieldTypeText string = "text"
+
+	// FormFieldTypeCheckbox represents a checkbox.
+	FormFieldTypeCheckbox string = "checkbox"
+
+	// FormFieldTypeRadio represents a group of radio buttons.
+	FormFieldTypeRadio string = "radio"
+
+	// FormFieldTypeChoice represents a list box or a combo box.
+	FormFieldTypeChoice string = "choice"
+
+	// FormFieldTypeButton represents a push button.
+	FormFieldTypeButton string = "button"
+
+	// FormFieldTypeSignature represents a signature field.
+	FormFieldTypeSignature string = "signature"
+)
+
+// FormField describes an AcroForm field of a PDF file.
+type FormField struct {
+	// Name is the fully qualified name of the field (e.g., "address.city").
+	Name string `json:"name"`
+
+	// Type is either "text", "checkbox", "radio", "choice", "button" or
+	// "signature".
+	Type string `json:"type"`
+
+	// Value is the current value of the field: a boolean for checkboxes, a
+	// list of strings for multiple choices, a string otherwise.
+	Value interface{} `json:"value"`
+
+	// DefaultValue is the value the field takes when the form is reset.
+	DefaultValue interface{} `json:"defaultValue"`
+
+	// Options are the available values of choices, radio buttons and
+	// checkboxes.
+	Options []string `json:"options,omitempty"`
+
+	// ReadOnly tells whether the user may change the value of the field.
+	ReadOnly bool `json:"readOnly"`
+
+	// Required tells whether the field must have a value when the form is
+	// submitted.
+	Required bool `json:"required"`
+
+	// Page is the number of the page of the first widget of the field,
+	// starting from 1.
+	Page int `json:"page"`
+
+	// Rect is the position of the first widget of the field in user space
+	// units (lower-left x, lower-left y, upper-right x, upper-right y).
+	Rect [4]float64 `json:"rect"`
+}
+
+// PdfPageText is the text of a PDF page.
+type PdfPageText struct {
+	// Page is the page number, starting from 1.
+	Page int `json:"page"`
+
+	// Text is the text of the page, in reading order.
+	Text string `json:"text"`
+}
+
+// EmbeddedFile is a file attached to a PDF.
+type EmbeddedFile struct {
+	// Name is the filename of the attachment.
+	Name string `json:"name"`
+
+	// Size is the size of the attachment, in bytes.
+	Size int64 `json:"size"`
+}
+
+// PdfInfo gathers information about a PDF file.
+type PdfInfo struct {
+	// Version is the PDF version (e.g., "1.7").
+	Version string `json:"version"`
+
+	// PageCount is the number of pages.
+	PageCount int `json:"pageCount"`
+
+	// Pages lists the information of each page, in order.
+	Pages []PdfPageInfo `json:"pages"`
+
+	// Linearized tells if the PDF is optimized for fast web view.
+	Linearized bool `json:"linearized"`
+
+	// Encrypted tells if the PDF is encrypted.
+	Encrypted bool `json:"encrypted"`
+
+	// Permissions lists what the PDF allows.
+	Permissions PdfPermissions `json:"permissions"`
+
+	// HasForm tells if the PDF has form fields, signature fields excluded.
+	HasForm bool `json:"hasForm"`
+
+	// HasSignatures tells if the PDF has at least one signed signature
+	// field.
+	HasSignatures bool `json:"hasSignatures"`
+
+	// PdfA is the PDF/A format claimed by the XMP metadata (e.g.,
+	// "PDF/A-2b"), if any.
+	PdfA string `json:"pdfa"`
+
+	// PdfUa tells if the XMP metadata claims PDF/UA compliance.
+	PdfUa bool `json:"pdfua"`
+}
+
+// PdfPageInfo gathers information about a page of a PDF file. Boxes are
+// expressed in points as [llx, lly, urx, ury].
+type PdfPageInfo struct {
+	// Number is the page number, starting from 1.
+	Number int `json:"number"`
+
+	// MediaBox is the boundaries of the physical medium.
+	MediaBox [4]float64 `json:"mediaBox"`
+
+	// CropBox is the boundaries of the visible region. It defaults to the
+	// media box.
+	CropBox [4]float64 `json:"cropBox"`
+
+	// Width is the width of the crop box, in points.
+	Width float64 `json:"width"`
+
+	// Height is the height of the crop box, in points.
+	Height float64 `json:"height"`
+
+	// Rotation is the clockwise rotation of the page when displayed, either
+	// 0, 90, 180 or 270.
+	Rotation int `json:"rotation"`
+}
+
+// PdfPermissions gathers the permissions of a PDF file. For a PDF without
+// encryption, everything is allowed.
+type PdfPermissions struct {
+	Print            bool `json:"print"`
+	PrintHighQuality bool `json:"printHighQuality"`
+	Modify           bool `json:"modify"`
+	Copy             bool `json:"copy"`
+	Annotate         bool `json:"annotate"`
+	FillForms        bool `json:"fillForms"`
+	Assemble         bool `json:"assemble"`
+	Accessibility    bool `json:"accessibility"`
+}
+
 // PdfEngine provides an interface for operations on PDFs. Implementations
 // can use various tools like PDFtk, or implement functionality directly in
 // Go.
@@ -138,15 +752,140 @@ type PdfEngine interface {
 	// WriteMetadata writes the metadata into a given PDF file.
 	WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error
 
-	// Encrypt adds password protection to a PDF file.
-	// The userPassword is required to open the document.
-	// The ownerPassword provides full access to the document.
-	// If the ownerPassword is empty, it defaults to the userPassword.
-	Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error
+	// Encrypt adds password protection to a PDF file, with the permissions
+	// and the algorithm given by EncryptOptions.
+	Encrypt(ctx context.Context, logger *zap.Logger, options EncryptOptions, inputPath string) error
 
 	// EmbedFiles embeds files into a PDF. All files are embedded as file attachments
 	// without modifying the main PDF content.
 	EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error
+
+	// Rotate rotates the pages of a PDF file clockwise by the given angle,
+	// which must be a multiple of 90. The pages follow the syntax of
+	// [ParsePageRanges]. If pages is empty, it rotates all pages.
+	Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error
+
+	// Watermark applies a text, an image or a PDF page to the pages of a PDF
+	// file.
+	Watermark(ctx context.Context, logger *zap.Logger, watermark Watermark, inputPath string) error
+
+	// Overlay lays the pages of another PDF over or under the pages of a PDF
+	// file.
+	Overlay(ctx context.Context, logger *zap.Logger, overlay Overlay, inputPath string) error
+
+	// Info retrieves information about a given PDF file, such as its page
+	// count, page boxes or encryption state.
+	Info(ctx context.Context, logger *zap.Logger, inputPath string) (PdfInfo, error)
+
+	// Optimize reduces the size of a PDF file.
+	Optimize(ctx context.Context, logger *zap.Logger, options OptimizeOptions, inputPath string) error
+
+	// Decrypt removes the password protection of a PDF file. The password
+	// is either the user or the owner password, and may be empty if the PDF
+	// only has an owner password.
+	Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error
+
+	// ReadBookmarks extracts the outline of a given PDF file.
+	ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]Bookmark, error)
+
+	// WriteBookmarks replaces the outline of a given PDF file. If there are
+	// no bookmarks, it removes the outline.
+	WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []Bookmark, inputPath string) error
+
+	// FillForm fills the AcroForm fields of a given PDF file. The values are
+	// keyed by the fully qualified field names and are either a string, a
+	// number, a boolean (checkboxes) or a list of strings (multiple choices).
+	FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error
+
+	// ReadFormFields lists the AcroForm fields of a given PDF file.
+	ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]FormField, error)
+
+	// ExtractText extracts the text of the pages of a given PDF file. Page
+	// ranges (e.g., "1-3, 5") select the pages; empty means all pages.
+	ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]PdfPageText, error)
+
+	// ListEmbeds lists the files attached to a given PDF file.
+	ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]EmbeddedFile, error)
+
+	// ExtractEmbeds extracts the files attached to a given PDF file into the
+	// output directory and returns their paths.
+	ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error)
+
+	// ExtractImages extracts the images of a given PDF file into the output
+	// directory and returns their paths. If pages is empty, it extracts the
+	// images of all pages.
+	ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error)
+
+	// Sanitize removes the selected categories of active or hidden content
+	// from a given PDF file.
+	Sanitize(ctx context.Context, logger *zap.Logger, options SanitizeOptions, inputPath string) error
+
+	// ImportImages converts images into a single PDF, one page per image. The
+	// resulting page order is determined by the order of files provided in
+	// inputPaths.
+	ImportImages(ctx context.Context, logger *zap.Logger, options ImportImagesOptions, inputPaths []string, outputPath string) error
+
+	// Rasterize renders the pages of a given PDF file as images into the
+	// output directory and returns their paths, in page order. Each image is
+	// named after its page number (e.g., "1.png").
+	Rasterize(ctx context.Context, logger *zap.Logger, options RasterizeOptions, inputPath, outputDirPath string) ([]string, error)
+
+	// Impose arranges the pages of a given PDF file on sheets, either in a
+	// grid or as a booklet, according to ImposeOptions.
+	Impose(ctx context.Context, logger *zap.Logger, options ImposeOptions, inputPath, outputPath string) error
+
+	// PageEdit edits the pages of a given PDF file by applying the
+	// instructions in order.
+	PageEdit(ctx context.Context, logger *zap.Logger, instructions []PageEditInstruction, inputPath string) error
+
+	// Sign digitally signs a given PDF file with a PAdES signature. As any
+	// later change invalidates the signature, it must be the last operation
+	// on the PDF.
+	Sign(ctx context.Context, logger *zap.Logger, options SignOptions, inputPath string) error
+
+	// VerifySignatures verifies the digital signatures of a given PDF file
+	// and returns them, in the order of the PDF file.
+	VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]PdfSignature, error)
+
+	// ValidateCompliance validates a given PDF file against the conformance
+	// profiles of the given formats, one report per profile. Without
+	// formats, it validates the PDF file against the profile it claims.
+	//
+	// It is not named Validate, as PDF engines are modules, and the
+	// [Validator] interface already takes this name.
+	ValidateCompliance(ctx context.Context, logger *zap.Logger, formats PdfFormats, inputPath string) ([]PdfValidationReport, error)
+
+	// Repair rebuilds the cross-reference table of a given PDF file and
+	// recovers its objects, in place.
+	Repair(ctx context.Context, logger *zap.Logger, inputPath string) error
+
+	// Resize resizes the pages of a given PDF file, either to a paper size
+	// or by a scale factor.
+	Resize(ctx context.Context, logger *zap.Logger, options ResizeOptions, inputPath string) error
+
+	// Crop sets the crop, trim and bleed boxes of the pages of a given PDF
+	// file.
+	Crop(ctx context.Context, logger *zap.Logger, options CropOptions, inputPath string) error
+}
+
+// PdfFormatsAdvertiser is an optional interface a [PdfEngine] may implement
+// to advertise the PDF formats its Convert method supports. Engines that do
+// not implement it are assumed to support any PDF format, and may return an
+// [ErrPdfFormatNotSupported] error at conversion time.
+type PdfFormatsAdvertiser interface {
+	// SupportedPdfFormats returns the supported PDF/A levels (e.g.,
+	// PDF/A-2b) and whether the PDF/UA format is supported.
+	SupportedPdfFormats() (pdfa []string, pdfua bool)
+}
+
+// OptionalPdfEngine is an optional interface a [PdfEngine] may implement when
+// it relies on binaries a deployment may not provide. Such a [PdfEngine] does
+// not fail at startup if its binaries are missing; instead, it reports itself
+// as unavailable, and the PDF engines manager leaves it out.
+type OptionalPdfEngine interface {
+	// Available tells whether the binaries of the [PdfEngine] are
+	// available.
+	Available() bool
 }
 
 // PdfEngineProvider offers an interface to instantiate a [PdfEngine].
@@ -17,11 +17,37 @@ import (
 	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
 )
 
-// EmbedsFormField represents the form field name for embedding files.
 const (
+	// EmbedsFormField represents the form field name for embedding files.
 	EmbedsFormField string = "embeds"
+
+	// WatermarkFormField represents the form field name for the watermark
+	// file.
+	WatermarkFormField string = "watermark"
+
+	// OverlayFormField represents the form field name for the PDF laid over
+	// the pages.
+	OverlayFormField string = "overlay"
+
+	// UnderlayFormField represents the form field name for the PDF laid under
+	// the pages.
+	UnderlayFormField string = "underlay"
+
+	// SignatureKeysFormField represents the form field name for the signing
+	// key and its certificates.
+	SignatureKeysFormField string = "signatureKeys"
 )
 
+// reservedFormFields lists the form field names whose files are not
+// considered as regular input files.
+var reservedFormFields = []string{
+	EmbedsFormField,
+	WatermarkFormField,
+	OverlayFormField,
+	UnderlayFormField,
+	SignatureKeysFormField,
+}
+
 // FormData is a helper for validating and hydrating values from a
 // "multipart/form-data" request.
 //
@@ -195,6 +221,21 @@ func (form *FormData) inches(key string, target *float64) *FormData {
 		return form
 	}
 
+	val, err := ParseInches(value)
+	if err != nil {
+		form.append(
+			fmt.Errorf("form field '%s' is invalid (got '%s', resulting to %w)", key, value, err),
+		)
+		return form
+	}
+
+	*target = val
+	return form
+}
+
+// ParseInches computes a value with a unit (pt, px, in, mm, cm or pc) to
+// inches. A value without unit is in inches.
+func ParseInches(value string) (float64, error) {
 	for _, unit := range []string{"pt", "px", "in", "mm", "cm", "pc"} {
 		if !strings.HasSuffix(value, unit) {
 			continue
@@ -202,40 +243,26 @@ func (form *FormData) inches(key string, target *float64) *FormData {
 
 		val, err := strconv.ParseFloat(strings.TrimSuffix(value, unit), 64)
 		if err != nil {
-			form.append(
-				fmt.Errorf("form field '%s' is invalid (got '%s', resulting to %w)", key, value, err),
-			)
-			return form
+			return 0, err
 		}
 
 		switch unit {
 		case "pt":
-			*target = val * (1.0 / 72.0)
+			return val * (1.0 / 72.0), nil
 		case "px":
-			*target = val * (1.0 / 96.0)
-		case "in":
-			*target = val
+			return val * (1.0 / 96.0), nil
 		case "mm":
-			*target = val * (1.0 / 25.4)
+			return val * (1.0 / 25.4), nil
 		case "cm":
-			*target = val * (1.0 / 2.54)
+			return val * (1.0 / 2.54), nil
 		case "pc":
-			*target = val * (1.0 / 6.0)
+			return val * (1.0 / 6.0), nil
+		default:
+			return val, nil
 		}
-
-		return form
-	}
-
-	val, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		form.append(
-			fmt.Errorf("form field '%s' is invalid (got '%s', resulting to %w)", key, value, err),
-		)
-		return form
 	}
 
-	*target = val
-	return form
+	return strconv.ParseFloat(value, 64)
 }
 
 // Custom helps to define a custom binding function for a form field.
@@ -385,6 +412,24 @@ func (form *FormData) Embeds(target *[]string) *FormData {
 	return form
 }
 
+// FieldPaths binds the absolute paths of the files uploaded with the given
+// form field name to a string slice variable.
+//
+//	var paths []string
+//
+//	ctx.FormData().FieldPaths("watermark", &paths)
+func (form *FormData) FieldPaths(field string, target *[]string) *FormData {
+	if form.errors != nil {
+		return form
+	}
+
+	if paths, ok := form.filesByField[field]; ok {
+		*target = append(*target, paths...)
+	}
+
+	return form
+}
+
 // MandatoryPaths binds the absolute paths of form data files, according to a
 // list of file extensions, to a string slice variable. It populates an error
 // if there is no file for given file extensions.
@@ -410,10 +455,13 @@ func (form *FormData) MandatoryPaths(extensions []string, target *[]string) *For
 // file extensions, to a string slice variable.
 // embeds are excluded.
 func (form *FormData) paths(extensions []string, target *[]string) *FormData {
-	embeds, ok := form.filesByField[EmbedsFormField]
+	var reserved []string
+	for _, field := range reservedFormFields {
+		reserved = append(reserved, form.filesByField[field]...)
+	}
 
 	for filename, path := range form.files {
-		if ok && slices.Contains(embeds, path) {
+		if slices.Contains(reserved, path) {
 			continue
 		}
 
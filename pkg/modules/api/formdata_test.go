@@ -1096,6 +1096,41 @@ func TestFormData_MandatoryInches(t *testing.T) {
 	}
 }
 
+func TestParseInches(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		value       string
+		expect      float64
+		expectError bool
+	}{
+		{scenario: "without unit", value: "2", expect: 2},
+		{scenario: "in", value: "2in", expect: 2},
+		{scenario: "pt", value: "144pt", expect: 2},
+		{scenario: "px", value: "192px", expect: 2},
+		{scenario: "mm", value: "50.8mm", expect: 2},
+		{scenario: "cm", value: "5.08cm", expect: 2},
+		{scenario: "pc", value: "12pc", expect: 2},
+		{scenario: "invalid value with unit", value: "foomm", expectError: true},
+		{scenario: "invalid value without unit", value: "foo", expectError: true},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			actual, err := ParseInches(tc.value)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if fmt.Sprintf("%.1f", actual) != fmt.Sprintf("%.1f", tc.expect) {
+				t.Errorf("expected %.1f but got %.1f", tc.expect, actual)
+			}
+		})
+	}
+}
+
 func TestFormData_Custom(t *testing.T) {
 	for _, tc := range []struct {
 		scenario     string
@@ -1630,6 +1665,42 @@ func TestFormData_Paths(t *testing.T) {
 			},
 			expectCount: 1,
 		},
+		{
+			scenario: "files except watermark",
+			form: &FormData{
+				files: map[string]string{
+					"foo.pdf":       "/foo.pdf",
+					"watermark.pdf": "/watermark.pdf",
+				},
+				filesByField: map[string][]string{
+					"watermark": {"/watermark.pdf"},
+				},
+			},
+			extensions: []string{".pdf"},
+			expect: []string{
+				"/foo.pdf",
+			},
+			expectCount: 1,
+		},
+		{
+			scenario: "files except overlay and underlay",
+			form: &FormData{
+				files: map[string]string{
+					"foo.pdf":      "/foo.pdf",
+					"overlay.pdf":  "/overlay.pdf",
+					"underlay.pdf": "/underlay.pdf",
+				},
+				filesByField: map[string][]string{
+					"overlay":  {"/overlay.pdf"},
+					"underlay": {"/underlay.pdf"},
+				},
+			},
+			extensions: []string{".pdf"},
+			expect: []string{
+				"/foo.pdf",
+			},
+			expectCount: 1,
+		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
 			var actual []string
@@ -1783,3 +1854,24 @@ func TestFormData_Embeds(t *testing.T) {
 		t.Errorf("expected %v but got %v", expected, actual)
 	}
 }
+
+func TestFormData_FieldPaths(t *testing.T) {
+	expected := []string{"/logo.png"}
+
+	var actual []string
+	form := &FormData{
+		files: map[string]string{
+			"foo.pdf":  "/foo.pdf",
+			"logo.png": "/logo.png",
+		},
+		filesByField: map[string][]string{
+			"files":     {"/foo.pdf"},
+			"watermark": {"/logo.png"},
+		},
+	}
+	form.FieldPaths("watermark", &actual)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v but got %v", expected, actual)
+	}
+}
@@ -630,6 +630,7 @@ func (mod *Chromium) Routes() ([]api.Route, error) {
 		screenshotHtmlRoute(mod),
 		convertMarkdownRoute(mod, mod.engine),
 		screenshotMarkdownRoute(mod),
+		stampPdfRoute(mod, mod.engine),
 	}, nil
 }
 
@@ -385,9 +385,15 @@ func convertUrlRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 			form, options := FormDataChromiumPdfOptions(ctx)
 			mode := pdfengines.FormDataPdfSplitMode(form, false)
 			pdfFormats := pdfengines.FormDataPdfFormats(form)
+			failOnNonCompliance := pdfengines.FormDataPdfFailOnNonCompliance(form)
 			metadata := pdfengines.FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := pdfengines.FormDataPdfEncrypt(form)
+			encryptOptions := pdfengines.FormDataPdfEncrypt(form)
+			signOptions := pdfengines.FormDataPdfSign(form, false)
 			embedPaths := pdfengines.FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := pdfengines.FormDataPdfRotate(form, false)
+			watermark := pdfengines.FormDataPdfWatermark(form, false)
+			overlays := pdfengines.FormDataPdfOverlays(form, false)
+			optimizeOptions := pdfengines.FormDataPdfOptimize(form, false)
 
 			var url string
 			err := form.
@@ -397,7 +403,7 @@ func convertUrlRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, metadata, userPassword, ownerPassword, embedPaths)
+			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, failOnNonCompliance, metadata, encryptOptions, embedPaths, rotateAngle, rotatePages, watermark, overlays, optimizeOptions, signOptions)
 			if err != nil {
 				return fmt.Errorf("convert URL to PDF: %w", err)
 			}
@@ -448,9 +454,15 @@ func convertHtmlRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 			form, options := FormDataChromiumPdfOptions(ctx)
 			mode := pdfengines.FormDataPdfSplitMode(form, false)
 			pdfFormats := pdfengines.FormDataPdfFormats(form)
+			failOnNonCompliance := pdfengines.FormDataPdfFailOnNonCompliance(form)
 			metadata := pdfengines.FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := pdfengines.FormDataPdfEncrypt(form)
+			encryptOptions := pdfengines.FormDataPdfEncrypt(form)
+			signOptions := pdfengines.FormDataPdfSign(form, false)
 			embedPaths := pdfengines.FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := pdfengines.FormDataPdfRotate(form, false)
+			watermark := pdfengines.FormDataPdfWatermark(form, false)
+			overlays := pdfengines.FormDataPdfOverlays(form, false)
+			optimizeOptions := pdfengines.FormDataPdfOptimize(form, false)
 
 			var inputPath string
 			err := form.
@@ -461,7 +473,7 @@ func convertHtmlRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 			}
 
 			url := fmt.Sprintf("file://%s", inputPath)
-			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, metadata, userPassword, ownerPassword, embedPaths)
+			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, failOnNonCompliance, metadata, encryptOptions, embedPaths, rotateAngle, rotatePages, watermark, overlays, optimizeOptions, signOptions)
 			if err != nil {
 				return fmt.Errorf("convert HTML to PDF: %w", err)
 			}
@@ -513,9 +525,15 @@ func convertMarkdownRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 			form, options := FormDataChromiumPdfOptions(ctx)
 			mode := pdfengines.FormDataPdfSplitMode(form, false)
 			pdfFormats := pdfengines.FormDataPdfFormats(form)
+			failOnNonCompliance := pdfengines.FormDataPdfFailOnNonCompliance(form)
 			metadata := pdfengines.FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := pdfengines.FormDataPdfEncrypt(form)
+			encryptOptions := pdfengines.FormDataPdfEncrypt(form)
+			signOptions := pdfengines.FormDataPdfSign(form, false)
 			embedPaths := pdfengines.FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := pdfengines.FormDataPdfRotate(form, false)
+			watermark := pdfengines.FormDataPdfWatermark(form, false)
+			overlays := pdfengines.FormDataPdfOverlays(form, false)
+			optimizeOptions := pdfengines.FormDataPdfOptimize(form, false)
 
 			var (
 				inputPath     string
@@ -535,7 +553,7 @@ func convertMarkdownRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("transform markdown file(s) to HTML: %w", err)
 			}
 
-			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, metadata, userPassword, ownerPassword, embedPaths)
+			err = convertUrl(ctx, chromium, engine, url, options, mode, pdfFormats, failOnNonCompliance, metadata, encryptOptions, embedPaths, rotateAngle, rotatePages, watermark, overlays, optimizeOptions, signOptions)
 			if err != nil {
 				return fmt.Errorf("convert markdown to PDF: %w", err)
 			}
@@ -584,6 +602,72 @@ func screenshotMarkdownRoute(chromium Api) api.Route {
 	}
 }
 
+// stampPdfRoute returns an [api.Route] which can stamp headers and footers,
+// such as page numbers, on PDF files.
+func stampPdfRoute(chromium Api, engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/chromium/stamp/pdf",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+			defaultPdfOptions := DefaultPdfOptions()
+
+			var (
+				inputPaths                                       []string
+				headerTemplate, footerTemplate                   string
+				marginTop, marginBottom, marginLeft, marginRight float64
+			)
+
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Content("header.html", &headerTemplate, defaultPdfOptions.HeaderTemplate).
+				Content("footer.html", &footerTemplate, defaultPdfOptions.FooterTemplate).
+				Inches("marginTop", &marginTop, defaultPdfOptions.MarginTop).
+				Inches("marginBottom", &marginBottom, defaultPdfOptions.MarginBottom).
+				Inches("marginLeft", &marginLeft, defaultPdfOptions.MarginLeft).
+				Inches("marginRight", &marginRight, defaultPdfOptions.MarginRight).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			if headerTemplate == defaultPdfOptions.HeaderTemplate && footerTemplate == defaultPdfOptions.FooterTemplate {
+				return api.WrapError(
+					errors.New("no header nor footer"),
+					api.NewSentinelHttpError(
+						http.StatusBadRequest,
+						"Invalid form data: either 'header.html' or 'footer.html' form files must be provided",
+					),
+				)
+			}
+
+			options := defaultPdfOptions
+			options.HeaderTemplate = headerTemplate
+			options.FooterTemplate = footerTemplate
+			options.MarginTop = marginTop
+			options.MarginBottom = marginBottom
+			options.MarginLeft = marginLeft
+			options.MarginRight = marginRight
+			options.PreferCssPageSize = true
+
+			for _, inputPath := range inputPaths {
+				err = stampPdf(ctx, chromium, engine, options, inputPath)
+				if err != nil {
+					return fmt.Errorf("stamp '%s': %w", inputPath, err)
+				}
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
 func markdownToHtml(ctx *api.Context, inputPath string, markdownPaths []string) (string, error) {
 	// We have to convert each Markdown file referenced in the HTML
 	// file to... HTML. Thanks to the "html/template" package, we are
@@ -659,7 +743,7 @@ func markdownToHtml(ctx *api.Context, inputPath string, markdownPaths []string)
 	return fmt.Sprintf("file://%s", inputPath), nil
 }
 
-func convertUrl(ctx *api.Context, chromium Api, engine gotenberg.PdfEngine, url string, options PdfOptions, mode gotenberg.SplitMode, pdfFormats gotenberg.PdfFormats, metadata map[string]interface{}, userPassword, ownerPassword string, embedPaths []string) error {
+func convertUrl(ctx *api.Context, chromium Api, engine gotenberg.PdfEngine, url string, options PdfOptions, mode gotenberg.SplitMode, pdfFormats gotenberg.PdfFormats, failOnNonCompliance bool, metadata map[string]interface{}, encryptOptions gotenberg.EncryptOptions, embedPaths []string, rotateAngle int, rotatePages string, watermark gotenberg.Watermark, overlays []gotenberg.Overlay, optimizeOptions gotenberg.OptimizeOptions, signOptions *gotenberg.SignOptions) error {
 	outputPath := ctx.GeneratePath(".pdf")
 	// See https://github.com/gotenberg/gotenberg/issues/1130.
 	filename := ctx.OutputFilename(outputPath)
@@ -721,11 +805,31 @@ func convertUrl(ctx *api.Context, chromium Api, engine gotenberg.PdfEngine, url
 		return fmt.Errorf("convert to PDF: %w", err)
 	}
 
+	err = pdfengines.RotateStub(ctx, engine, rotateAngle, rotatePages, []string{outputPath})
+	if err != nil {
+		return fmt.Errorf("rotate PDF: %w", err)
+	}
+
+	err = pdfengines.WatermarkStub(ctx, engine, watermark, []string{outputPath})
+	if err != nil {
+		return fmt.Errorf("watermark PDF: %w", err)
+	}
+
+	err = pdfengines.OverlayStub(ctx, engine, overlays, []string{outputPath})
+	if err != nil {
+		return fmt.Errorf("overlay PDF: %w", err)
+	}
+
 	outputPaths, err := pdfengines.SplitPdfStub(ctx, engine, mode, []string{outputPath})
 	if err != nil {
 		return fmt.Errorf("split PDF: %w", err)
 	}
 
+	err = pdfengines.OptimizeStub(ctx, engine, optimizeOptions, outputPaths)
+	if err != nil {
+		return fmt.Errorf("optimize PDFs: %w", err)
+	}
+
 	convertOutputPaths, err := pdfengines.ConvertStub(ctx, engine, pdfFormats, outputPaths)
 	if err != nil {
 		return fmt.Errorf("convert PDF(s): %w", err)
@@ -741,11 +845,26 @@ func convertUrl(ctx *api.Context, chromium Api, engine gotenberg.PdfEngine, url
 		return fmt.Errorf("write metadata: %w", err)
 	}
 
-	err = pdfengines.EncryptPdfStub(ctx, engine, userPassword, ownerPassword, convertOutputPaths)
+	err = pdfengines.LinearizeStub(ctx, engine, optimizeOptions, convertOutputPaths)
+	if err != nil {
+		return fmt.Errorf("linearize PDFs: %w", err)
+	}
+
+	err = pdfengines.ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, convertOutputPaths)
+	if err != nil {
+		return fmt.Errorf("validate PDFs compliance: %w", err)
+	}
+
+	err = pdfengines.EncryptPdfStub(ctx, engine, encryptOptions, convertOutputPaths)
 	if err != nil {
 		return fmt.Errorf("encrypt PDFs: %w", err)
 	}
 
+	err = pdfengines.SignStub(ctx, engine, signOptions, convertOutputPaths)
+	if err != nil {
+		return fmt.Errorf("sign PDFs: %w", err)
+	}
+
 	zeroValuedSplitMode := gotenberg.SplitMode{}
 	zeroValuedPdfFormats := gotenberg.PdfFormats{}
 	if mode != zeroValuedSplitMode && pdfFormats != zeroValuedPdfFormats {
@@ -787,6 +906,41 @@ func screenshotUrl(ctx *api.Context, chromium Api, url string, options Screensho
 	return nil
 }
 
+// stampPdf prints the header and footer templates of the given options with
+// Chromium on blank pages matching the pages of the PDF file, then lays the
+// result over them. As the templates see every page, the "pageNumber" and
+// "totalPages" classes number the whole PDF file.
+func stampPdf(ctx *api.Context, chromium Api, engine gotenberg.PdfEngine, options PdfOptions, inputPath string) error {
+	info, err := engine.Info(ctx, ctx.Log(), inputPath)
+	if err != nil {
+		return fmt.Errorf("get PDF info: %w", err)
+	}
+
+	if len(info.Pages) == 0 {
+		return errors.New("PDF has no pages")
+	}
+
+	htmlPath := ctx.GeneratePath(".html")
+	err = os.WriteFile(htmlPath, []byte(stampHtml(info.Pages)), 0o600)
+	if err != nil {
+		return fmt.Errorf("write stamp HTML: %w", err)
+	}
+
+	stampPath := ctx.GeneratePath(".pdf")
+	err = chromium.Pdf(ctx, ctx.Log(), fmt.Sprintf("file://%s", htmlPath), stampPath, options)
+	err = handleChromiumError(err, options.Options)
+	if err != nil {
+		return fmt.Errorf("print stamp: %w", err)
+	}
+
+	err = engine.Overlay(ctx, ctx.Log(), gotenberg.Overlay{Path: stampPath, Mode: gotenberg.OverlayModeSequence}, inputPath)
+	if err != nil {
+		return fmt.Errorf("overlay stamp: %w", err)
+	}
+
+	return nil
+}
+
 func handleChromiumError(err error, options Options) error {
 	if err == nil {
 		return nil
@@ -0,0 +1,58 @@
+package chromium
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// stampHtml returns an HTML document with one blank page for each of the
+// given pages, at the same size. Printed with the header and footer
+// templates, it gives the stamp to lay over the pages of the PDF file.
+func stampHtml(pages []gotenberg.PdfPageInfo) string {
+	var (
+		styles strings.Builder
+		body   strings.Builder
+	)
+
+	// CSS named pages, one for each distinct page size.
+	names := make(map[string]string)
+
+	for _, page := range pages {
+		width, height := page.Width, page.Height
+		if page.Rotation == 90 || page.Rotation == 270 {
+			width, height = height, width
+		}
+
+		size := fmt.Sprintf(
+			"%spt %spt",
+			strconv.FormatFloat(width, 'f', -1, 64),
+			strconv.FormatFloat(height, 'f', -1, 64),
+		)
+
+		name, ok := names[size]
+		if !ok {
+			name = fmt.Sprintf("size-%d", len(names)+1)
+			names[size] = name
+			fmt.Fprintf(&styles, "@page %s { size: %s; }\n.%s { page: %s; }\n", name, size, name, name)
+		}
+
+		fmt.Fprintf(&body, "<div class=\"page %s\"></div>\n", name)
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+<style>
+html, body { margin: 0; padding: 0; }
+.page { height: 1px; break-after: page; }
+.page:last-child { break-after: auto; }
+%s</style>
+</head>
+<body>
+%s</body>
+</html>
+`, styles.String(), body.String())
+}
@@ -0,0 +1,37 @@
+package chromium
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestStampHtml(t *testing.T) {
+	html := stampHtml([]gotenberg.PdfPageInfo{
+		{Number: 1, Width: 612, Height: 792},
+		{Number: 2, Width: 612, Height: 792},
+		{Number: 3, Width: 595.28, Height: 841.89, Rotation: 90},
+	})
+
+	for _, want := range []string{
+		"@page size-1 { size: 612pt 792pt; }",
+		"@page size-2 { size: 841.89pt 595.28pt; }",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected %q in HTML but got: %s", want, html)
+		}
+	}
+
+	if got := strings.Count(html, "@page "); got != 2 {
+		t.Errorf("expected 2 named pages but got %d", got)
+	}
+
+	if got := strings.Count(html, "<div class=\"page size-1\"></div>"); got != 2 {
+		t.Errorf("expected 2 pages of size-1 but got %d", got)
+	}
+
+	if got := strings.Count(html, "<div class=\"page size-2\"></div>"); got != 1 {
+		t.Errorf("expected 1 page of size-2 but got %d", got)
+	}
+}
@@ -177,7 +177,7 @@ func (engine *ExifTool) WriteMetadata(ctx context.Context, logger *zap.Logger, m
 }
 
 // Encrypt is not available in this implementation.
-func (engine *ExifTool) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+func (engine *ExifTool) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 	return fmt.Errorf("encrypt PDF using ExifTool: %w", gotenberg.ErrPdfEncryptionNotSupported)
 }
 
@@ -186,6 +186,131 @@ func (engine *ExifTool) EmbedFiles(ctx context.Context, logger *zap.Logger, file
 	return fmt.Errorf("embed files with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
+// Rotate is not available in this implementation.
+func (engine *ExifTool) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *ExifTool) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *ExifTool) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *ExifTool) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *ExifTool) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *ExifTool) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *ExifTool) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *ExifTool) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *ExifTool) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *ExifTool) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *ExifTool) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *ExifTool) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *ExifTool) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *ExifTool) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *ExifTool) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *ExifTool) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *ExifTool) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *ExifTool) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *ExifTool) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *ExifTool) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *ExifTool) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *ExifTool) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *ExifTool) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *ExifTool) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *ExifTool) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with ExifTool: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
 // Interface guards.
 var (
 	_ gotenberg.Module      = (*ExifTool)(nil)
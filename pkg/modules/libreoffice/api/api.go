@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -147,8 +149,8 @@ type Options struct {
 	// Possible values are: 75, 150, 300, 600 and 1200.
 	MaxImageResolution int
 
-	// PdfFormats allows to convert the resulting PDF to PDF/A-1b, PDF/A-2b,
-	// PDF/A-3b and PDF/UA.
+	// PdfFormats allows to convert the resulting PDF to PDF/A (see
+	// [SupportedPdfA]) and PDF/UA.
 	PdfFormats gotenberg.PdfFormats
 }
 
@@ -185,6 +187,12 @@ func DefaultOptions() Options {
 	}
 }
 
+// SupportedPdfA returns the PDF/A levels LibreOffice exports to, i.e.,
+// PDF/A-1b, PDF/A-2b, PDF/A-3b and PDF/A-4.
+func SupportedPdfA() []string {
+	return slices.Sorted(maps.Keys(pdfaExports))
+}
+
 // Uno is an abstraction on top of the Universal Network Objects API.
 type Uno interface {
 	Pdf(ctx context.Context, logger *zap.Logger, inputPath, outputPath string, options Options) error
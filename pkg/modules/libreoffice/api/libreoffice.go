@@ -27,6 +27,16 @@ type libreOfficeArguments struct {
 	startTimeout time.Duration
 }
 
+// pdfaExports maps the PDF/A levels to the PDF version LibreOffice exports
+// them with. LibreOffice only produces the level B conformance (or none for
+// PDF/A-4): the levels A and U, and PDF/A-4f, are not available.
+var pdfaExports = map[string]int{
+	gotenberg.PdfA1b: 1,
+	gotenberg.PdfA2b: 2,
+	gotenberg.PdfA3b: 3,
+	gotenberg.PdfA4:  4,
+}
+
 type libreOfficeProcess struct {
 	socketPort         int
 	userProfileDirPath string
@@ -302,16 +312,13 @@ func (p *libreOfficeProcess) pdf(ctx context.Context, logger *zap.Logger, inputP
 	args = append(args, "--export", fmt.Sprintf("ReduceImageResolution=%t", options.ReduceImageResolution))
 	args = append(args, "--export", fmt.Sprintf("MaxImageResolution=%d", options.MaxImageResolution))
 
-	switch options.PdfFormats.PdfA {
-	case "":
-	case gotenberg.PdfA1b:
-		args = append(args, "--export", "SelectPdfVersion=1")
-	case gotenberg.PdfA2b:
-		args = append(args, "--export", "SelectPdfVersion=2")
-	case gotenberg.PdfA3b:
-		args = append(args, "--export", "SelectPdfVersion=3")
-	default:
-		return ErrInvalidPdfFormats
+	if options.PdfFormats.PdfA != "" {
+		version, ok := pdfaExports[options.PdfFormats.PdfA]
+		if !ok {
+			return ErrInvalidPdfFormats
+		}
+
+		args = append(args, "--export", fmt.Sprintf("SelectPdfVersion=%d", version))
 	}
 
 	if options.PdfFormats.PdfUa {
@@ -61,10 +61,10 @@ func (engine *LibreOfficePdfEngine) Flatten(ctx context.Context, logger *zap.Log
 	return fmt.Errorf("flatten PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
-// Convert converts the given PDF to a specific PDF format. Currently, only the
-// PDF/A-1b, PDF/A-2b, PDF/A-3b and PDF/UA formats are available. If another
-// PDF format is requested, it returns a [gotenberg.ErrPdfFormatNotSupported]
-// error.
+// Convert converts the given PDF to a specific PDF format. See
+// [LibreOfficePdfEngine.SupportedPdfFormats] for the available formats. If
+// another PDF format is requested, it returns a
+// [gotenberg.ErrPdfFormatNotSupported] error.
 func (engine *LibreOfficePdfEngine) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
 	opts := api.DefaultOptions()
 	opts.PdfFormats = formats
@@ -81,6 +81,12 @@ func (engine *LibreOfficePdfEngine) Convert(ctx context.Context, logger *zap.Log
 	return fmt.Errorf("convert PDF to '%+v' with LibreOffice: %w", formats, err)
 }
 
+// SupportedPdfFormats returns the PDF/A levels LibreOffice exports to, and
+// tells that the PDF/UA format is available.
+func (engine *LibreOfficePdfEngine) SupportedPdfFormats() ([]string, bool) {
+	return api.SupportedPdfA(), true
+}
+
 // ReadMetadata is not available in this implementation.
 func (engine *LibreOfficePdfEngine) ReadMetadata(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error) {
 	return nil, fmt.Errorf("read PDF metadata with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
@@ -92,7 +98,7 @@ func (engine *LibreOfficePdfEngine) WriteMetadata(ctx context.Context, logger *z
 }
 
 // Encrypt is not available in this implementation.
-func (engine *LibreOfficePdfEngine) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+func (engine *LibreOfficePdfEngine) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 	return fmt.Errorf("encrypt PDF using LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
@@ -101,9 +107,135 @@ func (engine *LibreOfficePdfEngine) EmbedFiles(ctx context.Context, logger *zap.
 	return fmt.Errorf("embed files with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
+// Rotate is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *LibreOfficePdfEngine) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *LibreOfficePdfEngine) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *LibreOfficePdfEngine) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *LibreOfficePdfEngine) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *LibreOfficePdfEngine) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *LibreOfficePdfEngine) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with LibreOffice: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
 // Interface guards.
 var (
-	_ gotenberg.Module      = (*LibreOfficePdfEngine)(nil)
-	_ gotenberg.Provisioner = (*LibreOfficePdfEngine)(nil)
-	_ gotenberg.PdfEngine   = (*LibreOfficePdfEngine)(nil)
+	_ gotenberg.Module               = (*LibreOfficePdfEngine)(nil)
+	_ gotenberg.Provisioner          = (*LibreOfficePdfEngine)(nil)
+	_ gotenberg.PdfEngine            = (*LibreOfficePdfEngine)(nil)
+	_ gotenberg.PdfFormatsAdvertiser = (*LibreOfficePdfEngine)(nil)
 )
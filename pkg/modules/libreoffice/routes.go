@@ -29,9 +29,16 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 			form := ctx.FormData()
 			splitMode := pdfengines.FormDataPdfSplitMode(form, false)
 			pdfFormats := pdfengines.FormDataPdfFormats(form)
+			failOnNonCompliance := pdfengines.FormDataPdfFailOnNonCompliance(form)
 			metadata := pdfengines.FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := pdfengines.FormDataPdfEncrypt(form)
+			encryptOptions := pdfengines.FormDataPdfEncrypt(form)
+			signOptions := pdfengines.FormDataPdfSign(form, false)
 			embedPaths := pdfengines.FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := pdfengines.FormDataPdfRotate(form, false)
+			watermark := pdfengines.FormDataPdfWatermark(form, false)
+			overlays := pdfengines.FormDataPdfOverlays(form, false)
+			optimizeOptions := pdfengines.FormDataPdfOptimize(form, false)
+			bookmarksPerFile, bookmarkLabels := pdfengines.FormDataPdfMergeBookmarks(form)
 
 			zeroValuedSplitMode := gotenberg.SplitMode{}
 
@@ -136,6 +143,13 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
+			zeroValuedOptimizeOptions := gotenberg.OptimizeOptions{}
+			if optimizeOptions != zeroValuedOptimizeOptions {
+				// Optimizing may break the conformance to the PDF formats, so
+				// the conversion happens after the optimization instead.
+				nativePdfFormats = false
+			}
+
 			outputPaths := make([]string, len(inputPaths))
 			for i, inputPath := range inputPaths {
 				outputPaths[i] = ctx.GeneratePath(".pdf")
@@ -202,7 +216,12 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 			}
 
 			if merge {
-				outputPath, err := pdfengines.MergeStub(ctx, engine, outputPaths)
+				var labels []string
+				if bookmarksPerFile {
+					labels = pdfengines.MergeBookmarkLabels(inputPaths, bookmarkLabels)
+				}
+
+				outputPath, err := pdfengines.MergeStub(ctx, engine, outputPaths, labels)
 				if err != nil {
 					return fmt.Errorf("merge PDFs: %w", err)
 				}
@@ -211,6 +230,21 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				outputPaths = []string{outputPath}
 			}
 
+			err = pdfengines.RotateStub(ctx, engine, rotateAngle, rotatePages, outputPaths)
+			if err != nil {
+				return fmt.Errorf("rotate PDFs: %w", err)
+			}
+
+			err = pdfengines.WatermarkStub(ctx, engine, watermark, outputPaths)
+			if err != nil {
+				return fmt.Errorf("watermark PDFs: %w", err)
+			}
+
+			err = pdfengines.OverlayStub(ctx, engine, overlays, outputPaths)
+			if err != nil {
+				return fmt.Errorf("overlay PDFs: %w", err)
+			}
+
 			if splitMode != zeroValuedSplitMode {
 				if !merge {
 					// document.docx -> document.docx.pdf, so that split naming
@@ -233,6 +267,11 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				}
 			}
 
+			err = pdfengines.OptimizeStub(ctx, engine, optimizeOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("optimize PDFs: %w", err)
+			}
+
 			if !nativePdfFormats || (nativePdfFormats && splitMode != zeroValuedSplitMode) {
 				convertOutputPaths, err := pdfengines.ConvertStub(ctx, engine, pdfFormats, outputPaths)
 				if err != nil {
@@ -270,11 +309,26 @@ func convertRoute(libreOffice libreofficeapi.Uno, engine gotenberg.PdfEngine) ap
 				}
 			}
 
-			err = pdfengines.EncryptPdfStub(ctx, engine, userPassword, ownerPassword, outputPaths)
+			err = pdfengines.LinearizeStub(ctx, engine, optimizeOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("linearize PDFs: %w", err)
+			}
+
+			err = pdfengines.ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("validate PDFs compliance: %w", err)
+			}
+
+			err = pdfengines.EncryptPdfStub(ctx, engine, encryptOptions, outputPaths)
 			if err != nil {
 				return fmt.Errorf("encrypt PDFs: %w", err)
 			}
 
+			err = pdfengines.SignStub(ctx, engine, signOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("sign PDFs: %w", err)
+			}
+
 			if len(outputPaths) > 1 && splitMode == zeroValuedSplitMode {
 				// If .zip archive, document.docx -> document.docx.pdf.
 				for i, inputPath := range inputPaths {
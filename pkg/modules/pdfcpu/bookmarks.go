@@ -0,0 +1,68 @@
+package pdfcpu
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// bookmarkTree is the JSON format of the pdfcpu bookmarks import and export
+// commands.
+type bookmarkTree struct {
+	Bookmarks []bookmark `json:"bookmarks"`
+}
+
+type bookmark struct {
+	Title string     `json:"title"`
+	Page  int        `json:"page"`
+	Kids  []bookmark `json:"kids,omitempty"`
+}
+
+// unmarshalBookmarks converts the output of the pdfcpu bookmarks export
+// command.
+func unmarshalBookmarks(data []byte) ([]gotenberg.Bookmark, error) {
+	var tree bookmarkTree
+
+	err := json.Unmarshal(data, &tree)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pdfcpu JSON: %w", err)
+	}
+
+	var convert func(items []bookmark) []gotenberg.Bookmark
+	convert = func(items []bookmark) []gotenberg.Bookmark {
+		bookmarks := make([]gotenberg.Bookmark, len(items))
+		for i, item := range items {
+			bookmarks[i] = gotenberg.Bookmark{Title: item.Title, Page: item.Page}
+			if len(item.Kids) > 0 {
+				bookmarks[i].Children = convert(item.Kids)
+			}
+		}
+		return bookmarks
+	}
+
+	return convert(tree.Bookmarks), nil
+}
+
+// marshalBookmarks converts bookmarks to the input of the pdfcpu bookmarks
+// import command.
+func marshalBookmarks(bookmarks []gotenberg.Bookmark) ([]byte, error) {
+	var convert func(items []gotenberg.Bookmark) []bookmark
+	convert = func(items []gotenberg.Bookmark) []bookmark {
+		converted := make([]bookmark, len(items))
+		for i, item := range items {
+			converted[i] = bookmark{Title: item.Title, Page: item.Page}
+			if len(item.Children) > 0 {
+				converted[i].Kids = convert(item.Children)
+			}
+		}
+		return converted
+	}
+
+	data, err := json.Marshal(bookmarkTree{Bookmarks: convert(bookmarks)})
+	if err != nil {
+		return nil, fmt.Errorf("marshal pdfcpu JSON: %w", err)
+	}
+
+	return data, nil
+}
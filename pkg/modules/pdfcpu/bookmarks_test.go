@@ -0,0 +1,68 @@
+package pdfcpu
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestUnmarshalBookmarks(t *testing.T) {
+	for _, tc := range []struct {
+		scenario        string
+		data            string
+		expectBookmarks []gotenberg.Bookmark
+		expectError     bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			expectError: true,
+		},
+		{
+			scenario: "success",
+			data: `{
+				"header": {"source": "in.pdf", "version": "pdfcpu v0.9.1"},
+				"bookmarks": [
+					{"title": "Chapter 1", "page": 1, "kids": [{"title": "Section 1.1", "page": 2}]},
+					{"title": "Chapter 2", "page": 3, "bold": true}
+				]
+			}`,
+			expectBookmarks: []gotenberg.Bookmark{
+				{Title: "Chapter 1", Page: 1, Children: []gotenberg.Bookmark{{Title: "Section 1.1", Page: 2}}},
+				{Title: "Chapter 2", Page: 3},
+			},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			bookmarks, err := unmarshalBookmarks([]byte(tc.data))
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(bookmarks, tc.expectBookmarks) {
+				t.Errorf("expected %+v but got: %+v", tc.expectBookmarks, bookmarks)
+			}
+		})
+	}
+}
+
+func TestMarshalBookmarks(t *testing.T) {
+	data, err := marshalBookmarks([]gotenberg.Bookmark{
+		{Title: "Chapter 1", Page: 1, Children: []gotenberg.Bookmark{{Title: "Section 1.1", Page: 2}}},
+		{Title: "Chapter 2", Page: 3},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %v", err)
+	}
+
+	expect := `{"bookmarks":[{"title":"Chapter 1","page":1,"kids":[{"title":"Section 1.1","page":2}]},{"title":"Chapter 2","page":3}]}`
+	if string(data) != expect {
+		t.Errorf("expected '%s' but got '%s'", expect, string(data))
+	}
+}
@@ -0,0 +1,22 @@
+package pdfcpu
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// points converts inches to points, the default display unit of pdfcpu.
+func points(inches float64) string {
+	return strconv.FormatFloat(inches*72, 'f', 2, 64)
+}
+
+// boxMargins returns the pdfcpu description of page box margins, in the
+// top, right, bottom, left order.
+func boxMargins(margins gotenberg.BoxMargins) string {
+	return fmt.Sprintf(
+		"%s %s %s %s",
+		points(margins.Top), points(margins.Right), points(margins.Bottom), points(margins.Left),
+	)
+}
@@ -0,0 +1,33 @@
+package pdfcpu
+
+import (
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestBoxMargins(t *testing.T) {
+	for _, tc := range []struct {
+		scenario string
+		margins  gotenberg.BoxMargins
+		expect   string
+	}{
+		{
+			scenario: "zero margins",
+			expect:   "0.00 0.00 0.00 0.00",
+		},
+		{
+			scenario: "margins in inches",
+			margins:  gotenberg.BoxMargins{Top: 1, Right: 0.5, Bottom: 0.25, Left: 1.0 / 72.0},
+			expect:   "72.00 36.00 18.00 1.00",
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			actual := boxMargins(tc.margins)
+
+			if actual != tc.expect {
+				t.Errorf("expected '%s' but got: '%s'", tc.expect, actual)
+			}
+		})
+	}
+}
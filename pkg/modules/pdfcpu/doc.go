@@ -3,6 +3,16 @@
 //
 // 1. The merging of PDF files.
 // 2. The splitting of PDF files.
+// 3. The rotation of PDF pages.
+// 4. The watermarking and stamping of PDF pages.
+// 5. The optimization of PDF files.
+// 6. The decryption of PDF files.
+// 7. The reading and writing of bookmarks.
+// 8. The listing and extraction of embedded files.
+// 9. The extraction of images.
+// 10. The conversion of images to PDF.
+// 11. The imposition of PDF pages (n-up and booklet).
+// 12. The deletion and insertion of blank PDF pages.
 //
 // See: https://github.com/pdfcpu/pdfcpu.
 package pdfcpu
@@ -0,0 +1,33 @@
+package pdfcpu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// pageRanges translates a selection of pages (see
+// [gotenberg.ParsePageRanges]) to the pdfcpu page selection syntax, where
+// "8-" runs from page 8 until the last page.
+func pageRanges(selection string) (string, error) {
+	ranges, err := gotenberg.ParsePageRanges(selection)
+	if err != nil {
+		return "", err
+	}
+
+	values := make([]string, len(ranges))
+	for i, pageRange := range ranges {
+		switch pageRange.Last {
+		case pageRange.First:
+			values[i] = strconv.Itoa(pageRange.First)
+		case 0:
+			values[i] = fmt.Sprintf("%d-", pageRange.First)
+		default:
+			values[i] = fmt.Sprintf("%d-%d", pageRange.First, pageRange.Last)
+		}
+	}
+
+	return strings.Join(values, ","), nil
+}
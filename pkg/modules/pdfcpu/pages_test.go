@@ -0,0 +1,41 @@
+package pdfcpu
+
+import (
+	"testing"
+)
+
+func TestPageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		selection    string
+		expectRanges string
+		expectError  bool
+	}{
+		{
+			scenario:     "ranges",
+			selection:    "1-3, 5, 8-",
+			expectRanges: "1-3,5,8-",
+		},
+		{
+			scenario:    "pdfcpu syntax",
+			selection:   "odd",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ranges, err := pageRanges(tc.selection)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if ranges != tc.expectRanges {
+				t.Errorf("expected '%s' but got: '%s'", tc.expectRanges, ranges)
+			}
+		})
+	}
+}
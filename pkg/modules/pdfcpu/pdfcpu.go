@@ -7,7 +7,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -20,6 +22,34 @@ func init() {
 	gotenberg.MustRegisterModule(new(PdfCpu))
 }
 
+// watermarkPositions maps the watermark positions to their pdfcpu anchors.
+var watermarkPositions = map[string]string{
+	"center":        "c",
+	"top-left":      "tl",
+	"top-center":    "tc",
+	"top-right":     "tr",
+	"left":          "l",
+	"right":         "r",
+	"bottom-left":   "bl",
+	"bottom-center": "bc",
+	"bottom-right":  "br",
+}
+
+// imposeOrientations maps the impose orientations to their pdfcpu values.
+var imposeOrientations = map[string]string{
+	"right-down": "rd",
+	"down-right": "dr",
+	"left-down":  "ld",
+	"down-left":  "dl",
+}
+
+// imposePagesPerSheet lists the number of pages per sheet pdfcpu supports
+// for each impose mode.
+var imposePagesPerSheet = map[string][]int{
+	gotenberg.ImposeModeNup:     {2, 3, 4, 8, 9, 12, 16},
+	gotenberg.ImposeModeBooklet: {2, 4},
+}
+
 // PdfCpu abstracts the CLI tool pdfcpu and implements the
 // [gotenberg.PdfEngine] interface.
 type PdfCpu struct {
@@ -199,22 +229,64 @@ func (engine *PdfCpu) EmbedFiles(ctx context.Context, logger *zap.Logger, filePa
 	return nil
 }
 
-// Encrypt adds password protection to a PDF file using pdfcpu.
-func (engine *PdfCpu) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
-	if userPassword == "" {
+// Encrypt adds password protection to a PDF file using pdfcpu. It defaults to
+// the AES 256-bit encryption. Permissions are limited to either everything,
+// nothing, or printing only.
+func (engine *PdfCpu) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	if options.UserPassword == "" {
 		return errors.New("user password cannot be empty")
 	}
 
+	ownerPassword := options.OwnerPassword
 	if ownerPassword == "" {
-		ownerPassword = userPassword
+		ownerPassword = options.UserPassword
+	}
+
+	var mode, key string
+	switch options.Algorithm {
+	case "", gotenberg.EncryptionAlgorithmAes256:
+		mode, key = "aes", "256"
+	case gotenberg.EncryptionAlgorithmAes128:
+		mode, key = "aes", "128"
+	case gotenberg.EncryptionAlgorithmRc4128:
+		mode, key = "rc4", "128"
+	case gotenberg.EncryptionAlgorithmRc440:
+		mode, key = "rc4", "40"
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("encryption algorithm '%s' is not supported", options.Algorithm))
+	}
+
+	// Without permissions, it allows everything, as it always did.
+	perm := "all"
+	if options.Permissions != nil {
+		switch *options.Permissions {
+		case gotenberg.PdfPermissions{
+			Print:            true,
+			PrintHighQuality: true,
+			Modify:           true,
+			Copy:             true,
+			Annotate:         true,
+			FillForms:        true,
+			Assemble:         true,
+			Accessibility:    true,
+		}:
+			perm = "all"
+		case gotenberg.PdfPermissions{Print: true, PrintHighQuality: true}:
+			perm = "print"
+		case gotenberg.PdfPermissions{}:
+			perm = "none"
+		default:
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", "only all, printing or no permissions are supported")
+		}
 	}
 
 	var args []string
 	args = append(args, "encrypt")
-	args = append(args, "-mode", "aes")
-	args = append(args, "-upw", userPassword)
+	args = append(args, "-mode", mode)
+	args = append(args, "-key", key)
+	args = append(args, "-upw", options.UserPassword)
 	args = append(args, "-opw", ownerPassword)
-	args = append(args, "-perm", "all")
+	args = append(args, "-perm", perm)
 	args = append(args, inputPath, inputPath)
 
 	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
@@ -230,6 +302,626 @@ func (engine *PdfCpu) Encrypt(ctx context.Context, logger *zap.Logger, inputPath
 	return nil
 }
 
+// Rotate rotates the pages of a PDF file clockwise by the given angle.
+func (engine *PdfCpu) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	var args []string
+	args = append(args, "rotate")
+	if pages != "" {
+		ranges, err := pageRanges(pages)
+		if err != nil {
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", err.Error())
+		}
+
+		args = append(args, "-pages", ranges)
+	}
+	args = append(args, inputPath, strconv.Itoa(angle), inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("rotate PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// Watermark applies a text, an image or a PDF page to the pages of a PDF
+// file, either behind the page content (watermark) or over it (stamp).
+func (engine *PdfCpu) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	expression := watermark.Expression
+
+	switch watermark.Source {
+	case gotenberg.WatermarkSourceText, gotenberg.WatermarkSourceImage:
+	case gotenberg.WatermarkSourcePdf:
+		// Only the first page of the PDF.
+		expression = fmt.Sprintf("%s:1", expression)
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("watermark source '%s' is not supported", watermark.Source))
+	}
+
+	description := []string{fmt.Sprintf("rot:%g", watermark.Rotation)}
+
+	if watermark.Position != "" {
+		position, ok := watermarkPositions[watermark.Position]
+		if !ok {
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("watermark position '%s' is not supported", watermark.Position))
+		}
+		description = append(description, fmt.Sprintf("pos:%s", position))
+	}
+
+	if watermark.Opacity > 0 {
+		description = append(description, fmt.Sprintf("op:%g", watermark.Opacity))
+	}
+
+	if watermark.Scale > 0 {
+		description = append(description, fmt.Sprintf("scale:%g rel", watermark.Scale))
+	}
+
+	command := "watermark"
+	if watermark.Foreground {
+		command = "stamp"
+	}
+
+	var args []string
+	args = append(args, command, "add")
+	if watermark.Pages != "" {
+		args = append(args, "-pages", watermark.Pages)
+	}
+	args = append(args, "-mode", watermark.Source, "--")
+	args = append(args, expression, strings.Join(description, ", "))
+	args = append(args, inputPath, inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("watermark PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// Overlay is not available in this implementation.
+func (engine *PdfCpu) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *PdfCpu) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize reduces the size of a PDF file. It always compresses the streams,
+// packs the objects into object streams and removes duplicate resources.
+// Compressing images and linearizing are not available in this
+// implementation.
+func (engine *PdfCpu) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	if options.CompressImages {
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", "compressing images is not supported")
+	}
+
+	if options.Linearize {
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", "linearizing is not supported")
+	}
+
+	var args []string
+	args = append(args, "optimize", inputPath, inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("optimize PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// Decrypt removes the password protection of a PDF file. The password may
+// be either the owner or the user password.
+func (engine *PdfCpu) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	if password == "" {
+		return engine.decrypt(ctx, logger, inputPath)
+	}
+
+	// Both passwords differ when both are set, so we try the password as the
+	// owner password first, then as the user password.
+	err := engine.decrypt(ctx, logger, inputPath, "-opw", password)
+	if err == nil {
+		return nil
+	}
+
+	logger.Debug(fmt.Sprintf("decrypt with the password as owner password: %s", err))
+
+	return engine.decrypt(ctx, logger, inputPath, "-upw", password)
+}
+
+func (engine *PdfCpu) decrypt(ctx context.Context, logger *zap.Logger, inputPath string, passwordArgs ...string) error {
+	var args []string
+	args = append(args, "decrypt")
+	args = append(args, passwordArgs...)
+	args = append(args, inputPath, inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("decrypt PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// ReadBookmarks extracts the outline of a PDF file.
+func (engine *PdfCpu) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove pdfcpu JSON file: %s", err))
+		}
+	}()
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, "bookmarks", "export", inputPath, jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("read PDF bookmarks with pdfcpu: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("read pdfcpu JSON: %w", err)
+	}
+
+	return unmarshalBookmarks(data)
+}
+
+// WriteBookmarks replaces the outline of a PDF file.
+func (engine *PdfCpu) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	var args []string
+
+	if len(bookmarks) == 0 {
+		args = append(args, "bookmarks", "remove", inputPath, inputPath)
+	} else {
+		data, err := marshalBookmarks(bookmarks)
+		if err != nil {
+			return fmt.Errorf("marshal bookmarks: %w", err)
+		}
+
+		jsonPath := inputPath + ".json"
+		err = os.WriteFile(jsonPath, data, 0o600)
+		if err != nil {
+			return fmt.Errorf("write pdfcpu JSON: %w", err)
+		}
+
+		defer func() {
+			err := os.Remove(jsonPath)
+			if err != nil {
+				logger.Error(fmt.Sprintf("remove pdfcpu JSON file: %s", err))
+			}
+		}()
+
+		args = append(args, "bookmarks", "import", "-replace", inputPath, jsonPath, inputPath)
+	}
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("write PDF bookmarks with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// FillForm is not available in this implementation.
+func (engine *PdfCpu) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *PdfCpu) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *PdfCpu) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds lists the files attached to a PDF. As pdfcpu only prints the
+// list, it extracts the files in a temporary directory to gather their names
+// and sizes.
+func (engine *PdfCpu) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	dirPath, err := os.MkdirTemp(filepath.Dir(inputPath), "embeds")
+	if err != nil {
+		return nil, fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(dirPath)
+		if err != nil {
+			logger.Error(fmt.Sprintf("remove temporary directory '%s': %s", dirPath, err))
+		}
+	}()
+
+	paths, err := engine.ExtractEmbeds(ctx, logger, inputPath, dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("list embedded files: %w", err)
+	}
+
+	embeds := make([]gotenberg.EmbeddedFile, len(paths))
+	for i, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("stat embedded file: %w", err)
+		}
+
+		embeds[i] = gotenberg.EmbeddedFile{
+			Name: info.Name(),
+			Size: info.Size(),
+		}
+	}
+
+	return embeds, nil
+}
+
+// ExtractEmbeds extracts the files attached to a PDF.
+func (engine *PdfCpu) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, "attachments", "extract", inputPath, outputDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("extract embedded files with pdfcpu: %w", err)
+	}
+
+	outputPaths, err := listFiles(outputDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("walk directory to find extracted embedded files: %w", err)
+	}
+
+	return outputPaths, nil
+}
+
+// ExtractImages extracts the images of a PDF.
+func (engine *PdfCpu) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	var args []string
+	args = append(args, "extract", "-mode", "image")
+	if pages != "" {
+		args = append(args, "-pages", pages)
+	}
+	args = append(args, inputPath, outputDirPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("extract images with pdfcpu: %w", err)
+	}
+
+	outputPaths, err := listFiles(outputDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("walk directory to find extracted images: %w", err)
+	}
+
+	return outputPaths, nil
+}
+
+// ImportImages converts images into a single PDF.
+func (engine *PdfCpu) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	var params []string
+	if options.PageSize == "" {
+		params = append(params, "pos:full")
+	} else {
+		pageSize := options.PageSize
+		if options.Landscape {
+			pageSize += "L"
+		}
+		params = append(params, fmt.Sprintf("f:%s", pageSize))
+
+		if options.Center {
+			params = append(params, "pos:c")
+		} else {
+			params = append(params, "pos:tl")
+		}
+
+		if options.Fit {
+			params = append(params, "sc:1 rel")
+		} else {
+			params = append(params, "sc:1 abs")
+		}
+	}
+
+	if options.Dpi > 0 {
+		params = append(params, fmt.Sprintf("dpi:%d", options.Dpi))
+	}
+
+	args := []string{"import", strings.Join(params, ", "), outputPath}
+	args = append(args, inputPaths...)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("import images with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// listFiles returns the sorted paths of the files within a directory.
+func listFiles(dirPath string) ([]string, error) {
+	var paths []string
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, pathErr error) error {
+		if pathErr != nil {
+			return pathErr
+		}
+		if info.IsDir() {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// Sanitize is not available in this implementation.
+func (engine *PdfCpu) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *PdfCpu) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose arranges the pages of a PDF file on sheets with pdfcpu.
+func (engine *PdfCpu) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	supported, ok := imposePagesPerSheet[options.Mode]
+	if !ok {
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("impose mode '%s' is not supported", options.Mode))
+	}
+
+	if !slices.Contains(supported, options.PagesPerSheet) {
+		return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("%d pages per sheet is not supported with the '%s' mode", options.PagesPerSheet, options.Mode))
+	}
+
+	// A4 is the pdfcpu default.
+	pageSize := options.PageSize
+	if pageSize == "" {
+		pageSize = "A4"
+	}
+	if options.Landscape {
+		pageSize += "L"
+	}
+
+	border := "off"
+	if options.Border {
+		border = "on"
+	}
+
+	params := []string{
+		fmt.Sprintf("formsize:%s", pageSize),
+		fmt.Sprintf("border:%s", border),
+		fmt.Sprintf("margin:%g", options.Margin),
+	}
+
+	if options.Orientation != "" {
+		if options.Mode != gotenberg.ImposeModeNup {
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("impose orientation is not supported with the '%s' mode", options.Mode))
+		}
+
+		orientation, ok := imposeOrientations[options.Orientation]
+		if !ok {
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("impose orientation '%s' is not supported", options.Orientation))
+		}
+		params = append(params, fmt.Sprintf("orientation:%s", orientation))
+	}
+
+	args := []string{options.Mode, strings.Join(params, ", "), outputPath, strconv.Itoa(options.PagesPerSheet), inputPath}
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("impose PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// PageEdit edits the pages of a PDF file with pdfcpu, one command per
+// instruction. Moving and duplicating pages are not available in this
+// implementation.
+func (engine *PdfCpu) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	for _, instruction := range instructions {
+		pages := strings.Join(strings.Fields(instruction.Pages), "")
+		if pages == "" && (instruction.Op == gotenberg.PageEditKeep || instruction.Op == gotenberg.PageEditDelete) {
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("pages are required for the '%s' operation", instruction.Op))
+		}
+
+		var commands [][]string
+		switch instruction.Op {
+		case gotenberg.PageEditKeep:
+			commands = append(commands, []string{"trim", "-pages", pages, inputPath})
+		case gotenberg.PageEditDelete:
+			commands = append(commands, []string{"pages", "remove", "-pages", pages, inputPath})
+		case gotenberg.PageEditInsertBlank:
+			args := []string{"pages", "insert", "-pages", strconv.Itoa(instruction.After), "-mode", "after", inputPath}
+			if instruction.After == 0 {
+				args = []string{"pages", "insert", "-pages", "1", "-mode", "before", inputPath}
+			}
+
+			count := instruction.Count
+			if count == 0 {
+				count = 1
+			}
+
+			for i := 0; i < count; i++ {
+				commands = append(commands, args)
+			}
+		default:
+			return gotenberg.NewPdfEngineInvalidArgs("pdfcpu", fmt.Sprintf("page edit operation '%s' is not supported", instruction.Op))
+		}
+
+		for _, args := range commands {
+			cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+			if err != nil {
+				return fmt.Errorf("create command: %w", err)
+			}
+
+			_, err = cmd.Exec()
+			if err != nil {
+				return fmt.Errorf("edit PDF pages with pdfcpu: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sign is not available in this implementation.
+func (engine *PdfCpu) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *PdfCpu) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *PdfCpu) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *PdfCpu) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with pdfcpu: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize resizes the pages of a PDF file, either to a paper size or by a
+// scale factor.
+func (engine *PdfCpu) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	var description string
+	if options.PaperWidth > 0 && options.PaperHeight > 0 {
+		description = fmt.Sprintf("dimensions: %s %s", points(options.PaperWidth), points(options.PaperHeight))
+	} else {
+		description = fmt.Sprintf("scalefactor: %s", strconv.FormatFloat(options.Scale, 'f', -1, 64))
+	}
+
+	var args []string
+	args = append(args, "resize")
+	if options.Pages != "" {
+		args = append(args, "-pages", options.Pages)
+	}
+	args = append(args, "--", description, inputPath, inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("resize PDF with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
+// Crop sets the crop box of the pages of a PDF file with the crop command,
+// then their trim and bleed boxes with the boxes command.
+func (engine *PdfCpu) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	var pagesArgs []string
+	if options.Pages != "" {
+		pagesArgs = append(pagesArgs, "-pages", options.Pages)
+	}
+
+	if options.CropBox != nil {
+		var args []string
+		args = append(args, "crop")
+		args = append(args, pagesArgs...)
+		args = append(args, "--", boxMargins(*options.CropBox), inputPath, inputPath)
+
+		cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+		if err != nil {
+			return fmt.Errorf("create command: %w", err)
+		}
+
+		_, err = cmd.Exec()
+		if err != nil {
+			return fmt.Errorf("crop PDF with pdfcpu: %w", err)
+		}
+	}
+
+	var boxes []string
+	if options.TrimBox != nil {
+		boxes = append(boxes, fmt.Sprintf("trim: %s", boxMargins(*options.TrimBox)))
+	}
+	if options.BleedBox != nil {
+		boxes = append(boxes, fmt.Sprintf("bleed: %s", boxMargins(*options.BleedBox)))
+	}
+
+	if len(boxes) == 0 {
+		return nil
+	}
+
+	var args []string
+	args = append(args, "boxes", "add")
+	args = append(args, pagesArgs...)
+	args = append(args, "--", strings.Join(boxes, ", "), inputPath, inputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("set PDF page boxes with pdfcpu: %w", err)
+	}
+
+	return nil
+}
+
 // Interface guards.
 var (
 	_ gotenberg.Module      = (*PdfCpu)(nil)
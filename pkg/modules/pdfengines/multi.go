@@ -3,6 +3,7 @@ package pdfengines
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	"go.uber.org/multierr"
@@ -12,14 +13,39 @@ import (
 )
 
 type multiPdfEngines struct {
-	mergeEngines         []gotenberg.PdfEngine
-	splitEngines         []gotenberg.PdfEngine
-	flattenEngines       []gotenberg.PdfEngine
-	convertEngines       []gotenberg.PdfEngine
-	readMetadataEngines  []gotenberg.PdfEngine
-	writeMetadataEngines []gotenberg.PdfEngine
-	passwordEngines      []gotenberg.PdfEngine
-	embedEngines         []gotenberg.PdfEngine
+	mergeEngines            []gotenberg.PdfEngine
+	splitEngines            []gotenberg.PdfEngine
+	flattenEngines          []gotenberg.PdfEngine
+	convertEngines          []gotenberg.PdfEngine
+	readMetadataEngines     []gotenberg.PdfEngine
+	writeMetadataEngines    []gotenberg.PdfEngine
+	passwordEngines         []gotenberg.PdfEngine
+	embedEngines            []gotenberg.PdfEngine
+	rotateEngines           []gotenberg.PdfEngine
+	watermarkEngines        []gotenberg.PdfEngine
+	overlayEngines          []gotenberg.PdfEngine
+	infoEngines             []gotenberg.PdfEngine
+	optimizeEngines         []gotenberg.PdfEngine
+	decryptEngines          []gotenberg.PdfEngine
+	readBookmarksEngines    []gotenberg.PdfEngine
+	writeBookmarksEngines   []gotenberg.PdfEngine
+	fillFormEngines         []gotenberg.PdfEngine
+	readFormFieldsEngines   []gotenberg.PdfEngine
+	extractTextEngines      []gotenberg.PdfEngine
+	listEmbedsEngines       []gotenberg.PdfEngine
+	extractEmbedsEngines    []gotenberg.PdfEngine
+	extractImagesEngines    []gotenberg.PdfEngine
+	sanitizeEngines         []gotenberg.PdfEngine
+	importImagesEngines     []gotenberg.PdfEngine
+	rasterizeEngines        []gotenberg.PdfEngine
+	imposeEngines           []gotenberg.PdfEngine
+	pageEditEngines         []gotenberg.PdfEngine
+	signEngines             []gotenberg.PdfEngine
+	verifySignaturesEngines []gotenberg.PdfEngine
+	validateEngines         []gotenberg.PdfEngine
+	repairEngines           []gotenberg.PdfEngine
+	resizeEngines           []gotenberg.PdfEngine
+	cropEngines             []gotenberg.PdfEngine
 }
 
 func newMultiPdfEngines(
@@ -30,17 +56,67 @@ func newMultiPdfEngines(
 	readMetadataEngines,
 	writeMetadataEngines,
 	passwordEngines,
-	embedEngines []gotenberg.PdfEngine,
+	embedEngines,
+	rotateEngines,
+	watermarkEngines,
+	overlayEngines,
+	infoEngines,
+	optimizeEngines,
+	decryptEngines,
+	readBookmarksEngines,
+	writeBookmarksEngines,
+	fillFormEngines,
+	readFormFieldsEngines,
+	extractTextEngines,
+	listEmbedsEngines,
+	extractEmbedsEngines,
+	extractImagesEngines,
+	sanitizeEngines,
+	importImagesEngines,
+	rasterizeEngines,
+	imposeEngines,
+	pageEditEngines,
+	signEngines,
+	verifySignaturesEngines,
+	validateEngines,
+	repairEngines,
+	resizeEngines,
+	cropEngines []gotenberg.PdfEngine,
 ) *multiPdfEngines {
 	return &multiPdfEngines{
-		mergeEngines:         mergeEngines,
-		splitEngines:         splitEngines,
-		flattenEngines:       flattenEngines,
-		convertEngines:       convertEngines,
-		readMetadataEngines:  readMetadataEngines,
-		writeMetadataEngines: writeMetadataEngines,
-		passwordEngines:      passwordEngines,
-		embedEngines:         embedEngines,
+		mergeEngines:            mergeEngines,
+		splitEngines:            splitEngines,
+		flattenEngines:          flattenEngines,
+		convertEngines:          convertEngines,
+		readMetadataEngines:     readMetadataEngines,
+		writeMetadataEngines:    writeMetadataEngines,
+		passwordEngines:         passwordEngines,
+		embedEngines:            embedEngines,
+		rotateEngines:           rotateEngines,
+		watermarkEngines:        watermarkEngines,
+		overlayEngines:          overlayEngines,
+		infoEngines:             infoEngines,
+		optimizeEngines:         optimizeEngines,
+		decryptEngines:          decryptEngines,
+		readBookmarksEngines:    readBookmarksEngines,
+		writeBookmarksEngines:   writeBookmarksEngines,
+		fillFormEngines:         fillFormEngines,
+		readFormFieldsEngines:   readFormFieldsEngines,
+		extractTextEngines:      extractTextEngines,
+		listEmbedsEngines:       listEmbedsEngines,
+		extractEmbedsEngines:    extractEmbedsEngines,
+		extractImagesEngines:    extractImagesEngines,
+		sanitizeEngines:         sanitizeEngines,
+		importImagesEngines:     importImagesEngines,
+		rasterizeEngines:        rasterizeEngines,
+		imposeEngines:           imposeEngines,
+		pageEditEngines:         pageEditEngines,
+		signEngines:             signEngines,
+		verifySignaturesEngines: verifySignaturesEngines,
+		validateEngines:         validateEngines,
+		repairEngines:           repairEngines,
+		resizeEngines:           resizeEngines,
+		cropEngines:             cropEngines,
 	}
 }
 
@@ -131,12 +207,25 @@ func (multi *multiPdfEngines) Flatten(ctx context.Context, logger *zap.Logger, i
 }
 
 // Convert transforms the given PDF to a specific PDF format using the first
-// available engine that supports PDF conversion.
+// available engine that supports PDF conversion. Engines which advertise
+// their supported PDF formats are skipped if they do not support the
+// requested ones.
 func (multi *multiPdfEngines) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+	var engines []gotenberg.PdfEngine
+	for _, engine := range multi.convertEngines {
+		if supportsPdfFormats(engine, formats) {
+			engines = append(engines, engine)
+		}
+	}
+
+	if len(engines) == 0 {
+		return fmt.Errorf("convert PDF to '%+v' with multi PDF engines: %w", formats, gotenberg.ErrPdfFormatNotSupported)
+	}
+
 	var err error
 	errChan := make(chan error, 1)
 
-	for _, engine := range multi.convertEngines {
+	for _, engine := range engines {
 		go func(engine gotenberg.PdfEngine) {
 			errChan <- engine.Convert(ctx, logger, formats, inputPath, outputPath)
 		}(engine)
@@ -155,6 +244,28 @@ func (multi *multiPdfEngines) Convert(ctx context.Context, logger *zap.Logger, f
 	return fmt.Errorf("convert PDF to '%+v' with multi PDF engines: %w", formats, err)
 }
 
+// supportsPdfFormats checks whether the given engine supports the requested
+// PDF formats. It returns true if the engine does not advertise its supported
+// PDF formats.
+func supportsPdfFormats(engine gotenberg.PdfEngine, formats gotenberg.PdfFormats) bool {
+	advertiser, ok := engine.(gotenberg.PdfFormatsAdvertiser)
+	if !ok {
+		return true
+	}
+
+	pdfa, pdfua := advertiser.SupportedPdfFormats()
+
+	if formats.PdfA != "" && !slices.Contains(pdfa, formats.PdfA) {
+		return false
+	}
+
+	if formats.PdfUa && !pdfua {
+		return false
+	}
+
+	return true
+}
+
 type readMetadataResult struct {
 	metadata map[string]interface{}
 	err      error
@@ -218,13 +329,13 @@ func (multi *multiPdfEngines) WriteMetadata(ctx context.Context, logger *zap.Log
 
 // Encrypt adds password protection to a PDF file using the first available
 // engine that supports password protection.
-func (multi *multiPdfEngines) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+func (multi *multiPdfEngines) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 	var err error
 	errChan := make(chan error, 1)
 
 	for _, engine := range multi.passwordEngines {
 		go func(engine gotenberg.PdfEngine) {
-			errChan <- engine.Encrypt(ctx, logger, inputPath, userPassword, ownerPassword)
+			errChan <- engine.Encrypt(ctx, logger, options, inputPath)
 		}(engine)
 
 		select {
@@ -266,6 +377,746 @@ func (multi *multiPdfEngines) EmbedFiles(ctx context.Context, logger *zap.Logger
 	return fmt.Errorf("embed files into PDF using multi PDF engines: %w", err)
 }
 
+// Rotate rotates the pages of a PDF file using the first available engine
+// that supports page rotation.
+func (multi *multiPdfEngines) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.rotateEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Rotate(ctx, logger, angle, pages, inputPath)
+		}(engine)
+
+		select {
+		case rotateErr := <-errChan:
+			errored := multierr.AppendInto(&err, rotateErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("rotate PDF with multi PDF engines: %w", err)
+}
+
+// Watermark applies a watermark to a PDF file using the first available engine
+// that supports watermarking.
+func (multi *multiPdfEngines) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.watermarkEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Watermark(ctx, logger, watermark, inputPath)
+		}(engine)
+
+		select {
+		case watermarkErr := <-errChan:
+			errored := multierr.AppendInto(&err, watermarkErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("watermark PDF with multi PDF engines: %w", err)
+}
+
+// Overlay lays the pages of another PDF over or under the pages of a PDF file
+// using the first available engine that supports overlays.
+func (multi *multiPdfEngines) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.overlayEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Overlay(ctx, logger, overlay, inputPath)
+		}(engine)
+
+		select {
+		case overlayErr := <-errChan:
+			errored := multierr.AppendInto(&err, overlayErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("overlay PDF with multi PDF engines: %w", err)
+}
+
+type infoResult struct {
+	info gotenberg.PdfInfo
+	err  error
+}
+
+// Info retrieves information about a PDF file using the first available
+// engine that supports it.
+func (multi *multiPdfEngines) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.infoEngines {
+		resultChan := make(chan infoResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			info, err := engine.Info(ctx, logger, inputPath)
+			resultChan <- infoResult{info: info, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.info, nil
+			}
+		case <-ctx.Done():
+			return gotenberg.PdfInfo{}, ctx.Err()
+		}
+	}
+
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with multi PDF engines: %w", err)
+}
+
+// Optimize reduces the size of a PDF file using the first available engine
+// that supports optimization.
+func (multi *multiPdfEngines) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.optimizeEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Optimize(ctx, logger, options, inputPath)
+		}(engine)
+
+		select {
+		case optimizeErr := <-errChan:
+			errored := multierr.AppendInto(&err, optimizeErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("optimize PDF with multi PDF engines: %w", err)
+}
+
+// Decrypt removes the password protection of a PDF file using the first
+// available engine that supports decryption.
+func (multi *multiPdfEngines) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.decryptEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Decrypt(ctx, logger, inputPath, password)
+		}(engine)
+
+		select {
+		case decryptErr := <-errChan:
+			errored := multierr.AppendInto(&err, decryptErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("decrypt PDF with multi PDF engines: %w", err)
+}
+
+type readBookmarksResult struct {
+	bookmarks []gotenberg.Bookmark
+	err       error
+}
+
+// ReadBookmarks extracts the outline of a PDF file using the first available
+// engine that supports bookmarks reading.
+func (multi *multiPdfEngines) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.readBookmarksEngines {
+		resultChan := make(chan readBookmarksResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			bookmarks, err := engine.ReadBookmarks(ctx, logger, inputPath)
+			resultChan <- readBookmarksResult{bookmarks: bookmarks, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.bookmarks, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("read PDF bookmarks with multi PDF engines: %w", err)
+}
+
+// WriteBookmarks replaces the outline of a PDF file using the first available
+// engine that supports bookmarks writing.
+func (multi *multiPdfEngines) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.writeBookmarksEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.WriteBookmarks(ctx, logger, bookmarks, inputPath)
+		}(engine)
+
+		select {
+		case writeBookmarksErr := <-errChan:
+			errored := multierr.AppendInto(&err, writeBookmarksErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("write PDF bookmarks with multi PDF engines: %w", err)
+}
+
+// FillForm fills the AcroForm fields of a PDF file using the first available
+// engine that supports form filling.
+func (multi *multiPdfEngines) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.fillFormEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.FillForm(ctx, logger, values, inputPath)
+		}(engine)
+
+		select {
+		case fillFormErr := <-errChan:
+			errored := multierr.AppendInto(&err, fillFormErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("fill PDF form with multi PDF engines: %w", err)
+}
+
+type readFormFieldsResult struct {
+	fields []gotenberg.FormField
+	err    error
+}
+
+// ReadFormFields lists the AcroForm fields of a PDF file using the first
+// available engine that supports form fields reading.
+func (multi *multiPdfEngines) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.readFormFieldsEngines {
+		resultChan := make(chan readFormFieldsResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			fields, err := engine.ReadFormFields(ctx, logger, inputPath)
+			resultChan <- readFormFieldsResult{fields: fields, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.fields, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("read PDF form fields with multi PDF engines: %w", err)
+}
+
+type extractTextResult struct {
+	texts []gotenberg.PdfPageText
+	err   error
+}
+
+// ExtractText extracts the text of the pages of a PDF file using the first
+// available engine that supports text extraction.
+func (multi *multiPdfEngines) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	if len(multi.extractTextEngines) == 0 {
+		return nil, fmt.Errorf("extract text with multi PDF engines: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+	}
+
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.extractTextEngines {
+		resultChan := make(chan extractTextResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			texts, err := engine.ExtractText(ctx, logger, pageRanges, inputPath)
+			resultChan <- extractTextResult{texts: texts, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.texts, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("extract text with multi PDF engines: %w", err)
+}
+
+type listEmbedsResult struct {
+	embeds []gotenberg.EmbeddedFile
+	err    error
+}
+
+// ListEmbeds lists the files attached to a PDF file using the first available
+// engine that supports listing embedded files.
+func (multi *multiPdfEngines) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.listEmbedsEngines {
+		resultChan := make(chan listEmbedsResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			embeds, err := engine.ListEmbeds(ctx, logger, inputPath)
+			resultChan <- listEmbedsResult{embeds: embeds, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.embeds, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("list embedded files with multi PDF engines: %w", err)
+}
+
+// ExtractEmbeds extracts the files attached to a PDF file using the first
+// available engine that supports extracting embedded files.
+func (multi *multiPdfEngines) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.extractEmbedsEngines {
+		resultChan := make(chan splitResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			outputPaths, err := engine.ExtractEmbeds(ctx, logger, inputPath, outputDirPath)
+			resultChan <- splitResult{outputPaths: outputPaths, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.outputPaths, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("extract embedded files with multi PDF engines: %w", err)
+}
+
+// ExtractImages extracts the images of a PDF file using the first available
+// engine that supports extracting images.
+func (multi *multiPdfEngines) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.extractImagesEngines {
+		resultChan := make(chan splitResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			outputPaths, err := engine.ExtractImages(ctx, logger, pages, inputPath, outputDirPath)
+			resultChan <- splitResult{outputPaths: outputPaths, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.outputPaths, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("extract images with multi PDF engines: %w", err)
+}
+
+// Sanitize removes active or hidden content from a PDF file using the first
+// available engine that supports sanitizing.
+func (multi *multiPdfEngines) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.sanitizeEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Sanitize(ctx, logger, options, inputPath)
+		}(engine)
+
+		select {
+		case sanitizeErr := <-errChan:
+			errored := multierr.AppendInto(&err, sanitizeErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("sanitize PDF with multi PDF engines: %w", err)
+}
+
+// ImportImages converts images into a single PDF using the first available
+// engine that supports importing images.
+func (multi *multiPdfEngines) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.importImagesEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.ImportImages(ctx, logger, options, inputPaths, outputPath)
+		}(engine)
+
+		select {
+		case importImagesErr := <-errChan:
+			errored := multierr.AppendInto(&err, importImagesErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("import images with multi PDF engines: %w", err)
+}
+
+// Rasterize renders the pages of a PDF file as images using the first
+// available engine that supports rasterization.
+func (multi *multiPdfEngines) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	if len(multi.rasterizeEngines) == 0 {
+		return nil, fmt.Errorf("rasterize PDF with multi PDF engines: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+	}
+
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.rasterizeEngines {
+		resultChan := make(chan splitResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			outputPaths, err := engine.Rasterize(ctx, logger, options, inputPath, outputDirPath)
+			resultChan <- splitResult{outputPaths: outputPaths, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.outputPaths, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("rasterize PDF with multi PDF engines: %w", err)
+}
+
+// Impose arranges the pages of a PDF file on sheets using the first available
+// engine that supports imposition.
+func (multi *multiPdfEngines) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.imposeEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Impose(ctx, logger, options, inputPath, outputPath)
+		}(engine)
+
+		select {
+		case imposeErr := <-errChan:
+			errored := multierr.AppendInto(&err, imposeErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("impose PDF with multi PDF engines: %w", err)
+}
+
+// PageEdit edits the pages of a PDF file using the first available engine
+// that supports page editing.
+func (multi *multiPdfEngines) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.pageEditEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.PageEdit(ctx, logger, instructions, inputPath)
+		}(engine)
+
+		select {
+		case pageEditErr := <-errChan:
+			errored := multierr.AppendInto(&err, pageEditErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("edit PDF pages with multi PDF engines: %w", err)
+}
+
+// Sign digitally signs a PDF file using the first available engine that
+// supports signing.
+func (multi *multiPdfEngines) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	if len(multi.signEngines) == 0 {
+		return fmt.Errorf("sign PDF with multi PDF engines: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+	}
+
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.signEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Sign(ctx, logger, options, inputPath)
+		}(engine)
+
+		select {
+		case signErr := <-errChan:
+			errored := multierr.AppendInto(&err, signErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("sign PDF with multi PDF engines: %w", err)
+}
+
+type verifySignaturesResult struct {
+	signatures []gotenberg.PdfSignature
+	err        error
+}
+
+// VerifySignatures verifies the digital signatures of a PDF file using the
+// first available engine that supports signature verification.
+func (multi *multiPdfEngines) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	if len(multi.verifySignaturesEngines) == 0 {
+		return nil, fmt.Errorf("verify PDF signatures with multi PDF engines: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+	}
+
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.verifySignaturesEngines {
+		resultChan := make(chan verifySignaturesResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			signatures, err := engine.VerifySignatures(ctx, logger, inputPath)
+			resultChan <- verifySignaturesResult{signatures: signatures, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.signatures, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("verify PDF signatures with multi PDF engines: %w", err)
+}
+
+type validateComplianceResult struct {
+	reports []gotenberg.PdfValidationReport
+	err     error
+}
+
+// ValidateCompliance validates a PDF file against conformance profiles using
+// the first available engine that supports compliance validation.
+func (multi *multiPdfEngines) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	if len(multi.validateEngines) == 0 {
+		return nil, fmt.Errorf("validate PDF compliance with multi PDF engines: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+	}
+
+	var err error
+	var mu sync.Mutex // to safely append errors.
+
+	for _, engine := range multi.validateEngines {
+		resultChan := make(chan validateComplianceResult, 1)
+
+		go func(engine gotenberg.PdfEngine) {
+			reports, err := engine.ValidateCompliance(ctx, logger, formats, inputPath)
+			resultChan <- validateComplianceResult{reports: reports, err: err}
+		}(engine)
+
+		select {
+		case result := <-resultChan:
+			if result.err != nil {
+				mu.Lock()
+				err = multierr.Append(err, result.err)
+				mu.Unlock()
+			} else {
+				return result.reports, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, fmt.Errorf("validate PDF compliance with multi PDF engines: %w", err)
+}
+
+// Repair rebuilds the cross-reference table of a PDF file and recovers its
+// objects using the first available engine that supports repairing.
+func (multi *multiPdfEngines) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.repairEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Repair(ctx, logger, inputPath)
+		}(engine)
+
+		select {
+		case repairErr := <-errChan:
+			errored := multierr.AppendInto(&err, repairErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("repair PDF with multi PDF engines: %w", err)
+}
+
+// Resize resizes the pages of a PDF file using the first available engine
+// that supports resizing.
+func (multi *multiPdfEngines) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.resizeEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Resize(ctx, logger, options, inputPath)
+		}(engine)
+
+		select {
+		case resizeErr := <-errChan:
+			errored := multierr.AppendInto(&err, resizeErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("resize PDF with multi PDF engines: %w", err)
+}
+
+// Crop sets the page boxes of a PDF file using the first available engine
+// that supports cropping.
+func (multi *multiPdfEngines) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	var err error
+	errChan := make(chan error, 1)
+
+	for _, engine := range multi.cropEngines {
+		go func(engine gotenberg.PdfEngine) {
+			errChan <- engine.Crop(ctx, logger, options, inputPath)
+		}(engine)
+
+		select {
+		case cropErr := <-errChan:
+			errored := multierr.AppendInto(&err, cropErr)
+			if !errored {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return fmt.Errorf("crop PDF with multi PDF engines: %w", err)
+}
+
 // Interface guards.
 var (
 	_ gotenberg.PdfEngine = (*multiPdfEngines)(nil)
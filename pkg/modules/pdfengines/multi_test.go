@@ -115,7 +115,7 @@ func TestMultiPdfEngines_Encrypt(t *testing.T) {
 			engine: &multiPdfEngines{
 				passwordEngines: []gotenberg.PdfEngine{
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return nil
 						},
 					},
@@ -128,12 +128,12 @@ func TestMultiPdfEngines_Encrypt(t *testing.T) {
 			engine: &multiPdfEngines{
 				passwordEngines: []gotenberg.PdfEngine{
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return errors.New("foo")
 						},
 					},
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return nil
 						},
 					},
@@ -146,12 +146,12 @@ func TestMultiPdfEngines_Encrypt(t *testing.T) {
 			engine: &multiPdfEngines{
 				passwordEngines: []gotenberg.PdfEngine{
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return errors.New("foo")
 						},
 					},
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return errors.New("foo")
 						},
 					},
@@ -165,7 +165,7 @@ func TestMultiPdfEngines_Encrypt(t *testing.T) {
 			engine: &multiPdfEngines{
 				passwordEngines: []gotenberg.PdfEngine{
 					&gotenberg.PdfEngineMock{
-						EncryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
+						EncryptMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
 							return nil
 						},
 					},
@@ -181,7 +181,7 @@ func TestMultiPdfEngines_Encrypt(t *testing.T) {
 		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
-			err := tc.engine.Encrypt(tc.ctx, zap.NewNop(), "", "", "")
+			err := tc.engine.Encrypt(tc.ctx, zap.NewNop(), gotenberg.EncryptOptions{}, "")
 
 			if !tc.expectError && err != nil {
 				t.Fatalf("expected no error but got: %v", err)
@@ -380,6 +380,7 @@ func TestMultiPdfEngines_Convert(t *testing.T) {
 	for _, tc := range []struct {
 		scenario    string
 		engine      *multiPdfEngines
+		formats     gotenberg.PdfFormats
 		ctx         context.Context
 		expectError bool
 	}{
@@ -433,6 +434,60 @@ func TestMultiPdfEngines_Convert(t *testing.T) {
 			ctx:         context.Background(),
 			expectError: true,
 		},
+		{
+			scenario: "skip engines which do not support the requested PDF formats",
+			engine: &multiPdfEngines{
+				convertEngines: []gotenberg.PdfEngine{
+					struct {
+						*gotenberg.PdfEngineMock
+						*gotenberg.PdfFormatsAdvertiserMock
+					}{
+						PdfEngineMock: &gotenberg.PdfEngineMock{
+							ConvertMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+								return errors.New("foo")
+							},
+						},
+						PdfFormatsAdvertiserMock: &gotenberg.PdfFormatsAdvertiserMock{
+							SupportedPdfFormatsMock: func() ([]string, bool) {
+								return []string{gotenberg.PdfA1b}, false
+							},
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ConvertMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			formats: gotenberg.PdfFormats{PdfA: gotenberg.PdfA2u},
+			ctx:     context.Background(),
+		},
+		{
+			scenario: "no engine supports the requested PDF formats",
+			engine: &multiPdfEngines{
+				convertEngines: []gotenberg.PdfEngine{
+					struct {
+						*gotenberg.PdfEngineMock
+						*gotenberg.PdfFormatsAdvertiserMock
+					}{
+						PdfEngineMock: &gotenberg.PdfEngineMock{
+							ConvertMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+								return nil
+							},
+						},
+						PdfFormatsAdvertiserMock: &gotenberg.PdfFormatsAdvertiserMock{
+							SupportedPdfFormatsMock: func() ([]string, bool) {
+								return []string{gotenberg.PdfA2u}, false
+							},
+						},
+					},
+				},
+			},
+			formats:     gotenberg.PdfFormats{PdfA: gotenberg.PdfA2u, PdfUa: true},
+			ctx:         context.Background(),
+			expectError: true,
+		},
 		{
 			scenario: "context expired",
 			engine: &multiPdfEngines{
@@ -454,7 +509,7 @@ func TestMultiPdfEngines_Convert(t *testing.T) {
 		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
-			err := tc.engine.Convert(tc.ctx, zap.NewNop(), gotenberg.PdfFormats{}, "", "")
+			err := tc.engine.Convert(tc.ctx, zap.NewNop(), tc.formats, "", "")
 
 			if !tc.expectError && err != nil {
 				t.Fatalf("expected no error but got: %v", err)
@@ -648,3 +703,2308 @@ func TestMultiPdfEngines_WriteMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiPdfEngines_Rotate(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				rotateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				rotateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				rotateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				rotateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RotateMock: func(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Rotate(tc.ctx, zap.NewNop(), 90, "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Watermark(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				watermarkEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				watermarkEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				watermarkEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				watermarkEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WatermarkMock: func(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Watermark(tc.ctx, zap.NewNop(), gotenberg.Watermark{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Overlay(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				overlayEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				overlayEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				overlayEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				overlayEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OverlayMock: func(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Overlay(tc.ctx, zap.NewNop(), gotenberg.Overlay{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Info(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				infoEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				infoEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				infoEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				infoEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						InfoMock: func(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+							return gotenberg.PdfInfo{}, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.Info(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Optimize(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				optimizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				optimizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				optimizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				optimizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Optimize(tc.ctx, zap.NewNop(), gotenberg.OptimizeOptions{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Decrypt(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				decryptEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				decryptEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				decryptEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				decryptEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						DecryptMock: func(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Decrypt(tc.ctx, zap.NewNop(), "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ReadBookmarks(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				readBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				readBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				readBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				readBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadBookmarksMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ReadBookmarks(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_WriteBookmarks(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				writeBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				writeBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				writeBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				writeBookmarksEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						WriteBookmarksMock: func(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.WriteBookmarks(tc.ctx, zap.NewNop(), nil, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_FillForm(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				fillFormEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				fillFormEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				fillFormEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				fillFormEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						FillFormMock: func(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.FillForm(tc.ctx, zap.NewNop(), nil, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ReadFormFields(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				readFormFieldsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				readFormFieldsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				readFormFieldsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				readFormFieldsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ReadFormFieldsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ReadFormFields(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ExtractText(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				extractTextEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				extractTextEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				extractTextEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario:    "no engine",
+			engine:      &multiPdfEngines{},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				extractTextEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractTextMock: func(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ExtractText(tc.ctx, zap.NewNop(), "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ListEmbeds(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				listEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				listEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				listEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				listEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ListEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ListEmbeds(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ExtractEmbeds(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				extractEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				extractEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				extractEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				extractEmbedsEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractEmbedsMock: func(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ExtractEmbeds(tc.ctx, zap.NewNop(), "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ExtractImages(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				extractImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				extractImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				extractImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				extractImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ExtractImagesMock: func(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ExtractImages(tc.ctx, zap.NewNop(), "", "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Sanitize(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				sanitizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				sanitizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				sanitizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				sanitizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SanitizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Sanitize(tc.ctx, zap.NewNop(), gotenberg.SanitizeOptions{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ImportImages(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				importImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				importImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				importImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				importImagesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImportImagesMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.ImportImages(tc.ctx, zap.NewNop(), gotenberg.ImportImagesOptions{}, nil, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Rasterize(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				rasterizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				rasterizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				rasterizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario:    "no engine",
+			engine:      &multiPdfEngines{},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				rasterizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RasterizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.Rasterize(tc.ctx, zap.NewNop(), gotenberg.RasterizeOptions{}, "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Impose(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				imposeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				imposeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				imposeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				imposeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ImposeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Impose(tc.ctx, zap.NewNop(), gotenberg.ImposeOptions{}, "", "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_PageEdit(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				pageEditEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				pageEditEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				pageEditEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				pageEditEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						PageEditMock: func(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.PageEdit(tc.ctx, zap.NewNop(), nil, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Sign(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				signEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				signEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				signEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario:    "no engine",
+			engine:      &multiPdfEngines{},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				signEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						SignMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Sign(tc.ctx, zap.NewNop(), gotenberg.SignOptions{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_VerifySignatures(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				verifySignaturesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				verifySignaturesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				verifySignaturesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario:    "no engine",
+			engine:      &multiPdfEngines{},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				verifySignaturesEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						VerifySignaturesMock: func(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.VerifySignatures(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_ValidateCompliance(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				validateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				validateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				validateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario:    "no engine",
+			engine:      &multiPdfEngines{},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				validateEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ValidateComplianceMock: func(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+							return nil, nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			_, err := tc.engine.ValidateCompliance(tc.ctx, zap.NewNop(), gotenberg.PdfFormats{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Repair(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				repairEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				repairEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				repairEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				repairEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Repair(tc.ctx, zap.NewNop(), "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Resize(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				resizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				resizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				resizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				resizeEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						ResizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Resize(tc.ctx, zap.NewNop(), gotenberg.ResizeOptions{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestMultiPdfEngines_Crop(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		engine      *multiPdfEngines
+		ctx         context.Context
+		expectError bool
+	}{
+		{
+			scenario: "nominal behavior",
+			engine: &multiPdfEngines{
+				cropEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "at least one engine does not return an error",
+			engine: &multiPdfEngines{
+				cropEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: context.Background(),
+		},
+		{
+			scenario: "all engines return an error",
+			engine: &multiPdfEngines{
+				cropEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return errors.New("foo")
+						},
+					},
+				},
+			},
+			ctx:         context.Background(),
+			expectError: true,
+		},
+		{
+			scenario: "context expired",
+			engine: &multiPdfEngines{
+				cropEngines: []gotenberg.PdfEngine{
+					&gotenberg.PdfEngineMock{
+						CropMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+							return nil
+						},
+					},
+				},
+			},
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx
+			}(),
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			err := tc.engine.Crop(tc.ctx, zap.NewNop(), gotenberg.CropOptions{}, "")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
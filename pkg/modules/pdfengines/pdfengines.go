@@ -3,6 +3,7 @@ package pdfengines
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	flag "github.com/spf13/pflag"
@@ -27,16 +28,42 @@ func init() {
 // the [api.Router] interface to expose relevant PDF processing routes if
 // enabled.
 type PdfEngines struct {
-	mergeNames         []string
-	splitNames         []string
-	flattenNames       []string
-	convertNames       []string
-	readMetadataNames  []string
-	writeMetadataNames []string
-	encryptNames       []string
-	embedNames         []string
-	engines            []gotenberg.PdfEngine
-	disableRoutes      bool
+	mergeNames            []string
+	splitNames            []string
+	flattenNames          []string
+	convertNames          []string
+	readMetadataNames     []string
+	writeMetadataNames    []string
+	encryptNames          []string
+	embedNames            []string
+	rotateNames           []string
+	watermarkNames        []string
+	overlayNames          []string
+	infoNames             []string
+	optimizeNames         []string
+	decryptNames          []string
+	readBookmarksNames    []string
+	writeBookmarksNames   []string
+	fillFormNames         []string
+	readFormFieldsNames   []string
+	extractTextNames      []string
+	listEmbedsNames       []string
+	extractEmbedsNames    []string
+	extractImagesNames    []string
+	sanitizeNames         []string
+	importImagesNames     []string
+	rasterizeNames        []string
+	imposeNames           []string
+	pageEditNames         []string
+	signNames             []string
+	verifySignaturesNames []string
+	validateNames         []string
+	repairNames           []string
+	resizeNames           []string
+	cropNames             []string
+	engines               []gotenberg.PdfEngine
+	unavailableNames      []string
+	disableRoutes         bool
 }
 
 // Descriptor returns a PdfEngines' module descriptor.
@@ -53,6 +80,31 @@ func (mod *PdfEngines) Descriptor() gotenberg.ModuleDescriptor {
 			fs.StringSlice("pdfengines-write-metadata-engines", []string{"exiftool"}, "Set the PDF engines and their order for the write metadata feature - empty means all")
 			fs.StringSlice("pdfengines-encrypt-engines", []string{"qpdf", "pdftk", "pdfcpu"}, "Set the PDF engines and their order for the password protection feature - empty means all")
 			fs.StringSlice("pdfengines-embed-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the file embedding feature - empty means all")
+			fs.StringSlice("pdfengines-rotate-engines", []string{"pdfcpu", "qpdf", "pdftk"}, "Set the PDF engines and their order for the rotate feature - empty means all")
+			fs.StringSlice("pdfengines-watermark-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the watermark feature - empty means all")
+			fs.StringSlice("pdfengines-overlay-engines", []string{"qpdf", "pdftk"}, "Set the PDF engines and their order for the overlay feature - empty means all")
+			fs.StringSlice("pdfengines-info-engines", []string{"qpdf"}, "Set the PDF engines and their order for the info feature - empty means all")
+			fs.StringSlice("pdfengines-optimize-engines", []string{"qpdf", "pdfcpu"}, "Set the PDF engines and their order for the optimize feature - empty means all")
+			fs.StringSlice("pdfengines-decrypt-engines", []string{"qpdf", "pdftk", "pdfcpu"}, "Set the PDF engines and their order for the decrypt feature - empty means all")
+			fs.StringSlice("pdfengines-read-bookmarks-engines", []string{"qpdf", "pdfcpu"}, "Set the PDF engines and their order for the read bookmarks feature - empty means all")
+			fs.StringSlice("pdfengines-write-bookmarks-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the write bookmarks feature - empty means all")
+			fs.StringSlice("pdfengines-fill-form-engines", []string{"pdftk"}, "Set the PDF engines and their order for the fill form feature - empty means all")
+			fs.StringSlice("pdfengines-read-form-fields-engines", []string{"qpdf"}, "Set the PDF engines and their order for the read form fields feature - empty means all")
+			fs.StringSlice("pdfengines-extract-text-engines", []string{"pdftotext"}, "Set the PDF engines and their order for the extract text feature - empty means all")
+			fs.StringSlice("pdfengines-list-embeds-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the list embedded files feature - empty means all")
+			fs.StringSlice("pdfengines-extract-embeds-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the extract embedded files feature - empty means all")
+			fs.StringSlice("pdfengines-extract-images-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the extract images feature - empty means all")
+			fs.StringSlice("pdfengines-sanitize-engines", []string{"qpdf"}, "Set the PDF engines and their order for the sanitize feature - empty means all")
+			fs.StringSlice("pdfengines-import-images-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the import images feature - empty means all")
+			fs.StringSlice("pdfengines-rasterize-engines", []string{"pdftoppm"}, "Set the PDF engines and their order for the rasterize feature - empty means all")
+			fs.StringSlice("pdfengines-impose-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the impose feature - empty means all")
+			fs.StringSlice("pdfengines-page-edit-engines", []string{"qpdf", "pdfcpu"}, "Set the PDF engines and their order for the page edit feature - empty means all")
+			fs.StringSlice("pdfengines-sign-engines", []string{"pyhanko"}, "Set the PDF engines and their order for the sign feature - empty means all")
+			fs.StringSlice("pdfengines-verify-signatures-engines", []string{"pyhanko"}, "Set the PDF engines and their order for the verify signatures feature - empty means all")
+			fs.StringSlice("pdfengines-validate-engines", []string{"verapdf"}, "Set the PDF engines and their order for the validate feature - empty means all")
+			fs.StringSlice("pdfengines-repair-engines", []string{"qpdf"}, "Set the PDF engines and their order for the repair feature - empty means all")
+			fs.StringSlice("pdfengines-resize-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the resize feature - empty means all")
+			fs.StringSlice("pdfengines-crop-engines", []string{"pdfcpu"}, "Set the PDF engines and their order for the crop feature - empty means all")
 			fs.Bool("pdfengines-disable-routes", false, "Disable the routes")
 
 			// Deprecated flags.
@@ -69,7 +121,8 @@ func (mod *PdfEngines) Descriptor() gotenberg.ModuleDescriptor {
 }
 
 // Provision gets either all [gotenberg.PdfEngine] modules or the modules
-// selected by the user thanks to the "engines" flag.
+// selected by the user thanks to the "engines" flag. It leaves out the
+// [gotenberg.OptionalPdfEngine] modules that are not available.
 func (mod *PdfEngines) Provision(ctx *gotenberg.Context) error {
 	flags := ctx.ParsedFlags()
 	mergeNames := flags.MustStringSlice("pdfengines-merge-engines")
@@ -80,6 +133,31 @@ func (mod *PdfEngines) Provision(ctx *gotenberg.Context) error {
 	writeMetadataNames := flags.MustStringSlice("pdfengines-write-metadata-engines")
 	encryptNames := flags.MustStringSlice("pdfengines-encrypt-engines")
 	embedNames := flags.MustStringSlice("pdfengines-embed-engines")
+	rotateNames := flags.MustStringSlice("pdfengines-rotate-engines")
+	watermarkNames := flags.MustStringSlice("pdfengines-watermark-engines")
+	overlayNames := flags.MustStringSlice("pdfengines-overlay-engines")
+	infoNames := flags.MustStringSlice("pdfengines-info-engines")
+	optimizeNames := flags.MustStringSlice("pdfengines-optimize-engines")
+	decryptNames := flags.MustStringSlice("pdfengines-decrypt-engines")
+	readBookmarksNames := flags.MustStringSlice("pdfengines-read-bookmarks-engines")
+	writeBookmarksNames := flags.MustStringSlice("pdfengines-write-bookmarks-engines")
+	fillFormNames := flags.MustStringSlice("pdfengines-fill-form-engines")
+	readFormFieldsNames := flags.MustStringSlice("pdfengines-read-form-fields-engines")
+	extractTextNames := flags.MustStringSlice("pdfengines-extract-text-engines")
+	listEmbedsNames := flags.MustStringSlice("pdfengines-list-embeds-engines")
+	extractEmbedsNames := flags.MustStringSlice("pdfengines-extract-embeds-engines")
+	extractImagesNames := flags.MustStringSlice("pdfengines-extract-images-engines")
+	sanitizeNames := flags.MustStringSlice("pdfengines-sanitize-engines")
+	importImagesNames := flags.MustStringSlice("pdfengines-import-images-engines")
+	rasterizeNames := flags.MustStringSlice("pdfengines-rasterize-engines")
+	imposeNames := flags.MustStringSlice("pdfengines-impose-engines")
+	pageEditNames := flags.MustStringSlice("pdfengines-page-edit-engines")
+	signNames := flags.MustStringSlice("pdfengines-sign-engines")
+	verifySignaturesNames := flags.MustStringSlice("pdfengines-verify-signatures-engines")
+	validateNames := flags.MustStringSlice("pdfengines-validate-engines")
+	repairNames := flags.MustStringSlice("pdfengines-repair-engines")
+	resizeNames := flags.MustStringSlice("pdfengines-resize-engines")
+	cropNames := flags.MustStringSlice("pdfengines-crop-engines")
 	mod.disableRoutes = flags.MustBool("pdfengines-disable-routes")
 
 	engines, err := ctx.Modules(new(gotenberg.PdfEngine))
@@ -87,10 +165,19 @@ func (mod *PdfEngines) Provision(ctx *gotenberg.Context) error {
 		return fmt.Errorf("get PDF engines: %w", err)
 	}
 
-	mod.engines = make([]gotenberg.PdfEngine, len(engines))
+	mod.engines = make([]gotenberg.PdfEngine, 0, len(engines))
+	mod.unavailableNames = make([]string, 0)
 
-	for i, engine := range engines {
-		mod.engines[i] = engine.(gotenberg.PdfEngine)
+	for _, engine := range engines {
+		// An optional engine without its binaries is left out, so that its
+		// features are not available instead of failing at startup.
+		optional, ok := engine.(gotenberg.OptionalPdfEngine)
+		if ok && !optional.Available() {
+			mod.unavailableNames = append(mod.unavailableNames, engine.(gotenberg.Module).Descriptor().ID)
+			continue
+		}
+
+		mod.engines = append(mod.engines, engine.(gotenberg.PdfEngine))
 	}
 
 	defaultNames := make([]string, len(mod.engines))
@@ -108,47 +195,185 @@ func (mod *PdfEngines) Provision(ctx *gotenberg.Context) error {
 
 	mod.mergeNames = defaultNames
 	if len(mergeNames) > 0 {
-		mod.mergeNames = mergeNames
+		mod.mergeNames = mod.availableNames(mergeNames)
 	}
 
 	mod.splitNames = defaultNames
 	if len(splitNames) > 0 {
-		mod.splitNames = splitNames
+		mod.splitNames = mod.availableNames(splitNames)
 	}
 
 	mod.flattenNames = defaultNames
 	if len(flattenNames) > 0 {
-		mod.flattenNames = flattenNames
+		mod.flattenNames = mod.availableNames(flattenNames)
 	}
 
 	mod.convertNames = defaultNames
 	if len(convertNames) > 0 {
-		mod.convertNames = convertNames
+		mod.convertNames = mod.availableNames(convertNames)
 	}
 
 	mod.readMetadataNames = defaultNames
 	if len(readMetadataNames) > 0 {
-		mod.readMetadataNames = readMetadataNames
+		mod.readMetadataNames = mod.availableNames(readMetadataNames)
 	}
 
 	mod.writeMetadataNames = defaultNames
 	if len(writeMetadataNames) > 0 {
-		mod.writeMetadataNames = writeMetadataNames
+		mod.writeMetadataNames = mod.availableNames(writeMetadataNames)
 	}
 
 	mod.encryptNames = defaultNames
 	if len(encryptNames) > 0 {
-		mod.encryptNames = encryptNames
+		mod.encryptNames = mod.availableNames(encryptNames)
 	}
 
 	mod.embedNames = defaultNames
 	if len(embedNames) > 0 {
-		mod.embedNames = embedNames
+		mod.embedNames = mod.availableNames(embedNames)
+	}
+
+	mod.rotateNames = defaultNames
+	if len(rotateNames) > 0 {
+		mod.rotateNames = mod.availableNames(rotateNames)
+	}
+
+	mod.watermarkNames = defaultNames
+	if len(watermarkNames) > 0 {
+		mod.watermarkNames = mod.availableNames(watermarkNames)
+	}
+
+	mod.overlayNames = defaultNames
+	if len(overlayNames) > 0 {
+		mod.overlayNames = mod.availableNames(overlayNames)
+	}
+
+	mod.infoNames = defaultNames
+	if len(infoNames) > 0 {
+		mod.infoNames = mod.availableNames(infoNames)
+	}
+
+	mod.optimizeNames = defaultNames
+	if len(optimizeNames) > 0 {
+		mod.optimizeNames = mod.availableNames(optimizeNames)
+	}
+
+	mod.decryptNames = defaultNames
+	if len(decryptNames) > 0 {
+		mod.decryptNames = mod.availableNames(decryptNames)
+	}
+
+	mod.readBookmarksNames = defaultNames
+	if len(readBookmarksNames) > 0 {
+		mod.readBookmarksNames = mod.availableNames(readBookmarksNames)
+	}
+
+	mod.writeBookmarksNames = defaultNames
+	if len(writeBookmarksNames) > 0 {
+		mod.writeBookmarksNames = mod.availableNames(writeBookmarksNames)
+	}
+
+	mod.fillFormNames = defaultNames
+	if len(fillFormNames) > 0 {
+		mod.fillFormNames = mod.availableNames(fillFormNames)
+	}
+
+	mod.readFormFieldsNames = defaultNames
+	if len(readFormFieldsNames) > 0 {
+		mod.readFormFieldsNames = mod.availableNames(readFormFieldsNames)
+	}
+
+	mod.extractTextNames = defaultNames
+	if len(extractTextNames) > 0 {
+		mod.extractTextNames = mod.availableNames(extractTextNames)
+	}
+
+	mod.listEmbedsNames = defaultNames
+	if len(listEmbedsNames) > 0 {
+		mod.listEmbedsNames = mod.availableNames(listEmbedsNames)
+	}
+
+	mod.extractEmbedsNames = defaultNames
+	if len(extractEmbedsNames) > 0 {
+		mod.extractEmbedsNames = mod.availableNames(extractEmbedsNames)
+	}
+
+	mod.extractImagesNames = defaultNames
+	if len(extractImagesNames) > 0 {
+		mod.extractImagesNames = mod.availableNames(extractImagesNames)
+	}
+
+	mod.sanitizeNames = defaultNames
+	if len(sanitizeNames) > 0 {
+		mod.sanitizeNames = mod.availableNames(sanitizeNames)
+	}
+
+	mod.importImagesNames = defaultNames
+	if len(importImagesNames) > 0 {
+		mod.importImagesNames = mod.availableNames(importImagesNames)
+	}
+
+	mod.rasterizeNames = defaultNames
+	if len(rasterizeNames) > 0 {
+		mod.rasterizeNames = mod.availableNames(rasterizeNames)
+	}
+
+	mod.imposeNames = defaultNames
+	if len(imposeNames) > 0 {
+		mod.imposeNames = mod.availableNames(imposeNames)
+	}
+
+	mod.pageEditNames = defaultNames
+	if len(pageEditNames) > 0 {
+		mod.pageEditNames = mod.availableNames(pageEditNames)
+	}
+
+	mod.signNames = defaultNames
+	if len(signNames) > 0 {
+		mod.signNames = mod.availableNames(signNames)
+	}
+
+	mod.verifySignaturesNames = defaultNames
+	if len(verifySignaturesNames) > 0 {
+		mod.verifySignaturesNames = mod.availableNames(verifySignaturesNames)
+	}
+
+	mod.validateNames = defaultNames
+	if len(validateNames) > 0 {
+		mod.validateNames = mod.availableNames(validateNames)
+	}
+
+	mod.repairNames = defaultNames
+	if len(repairNames) > 0 {
+		mod.repairNames = mod.availableNames(repairNames)
+	}
+
+	mod.resizeNames = defaultNames
+	if len(resizeNames) > 0 {
+		mod.resizeNames = mod.availableNames(resizeNames)
+	}
+
+	mod.cropNames = defaultNames
+	if len(cropNames) > 0 {
+		mod.cropNames = mod.availableNames(cropNames)
 	}
 
 	return nil
 }
 
+// availableNames returns the given engine names, without the names of the
+// unavailable engines.
+func (mod *PdfEngines) availableNames(names []string) []string {
+	available := make([]string, 0, len(names))
+	for _, name := range names {
+		if !slices.Contains(mod.unavailableNames, name) {
+			available = append(available, name)
+		}
+	}
+
+	return available
+}
+
 // Validate validates there is at least one [gotenberg.PdfEngine] module
 // available. It also validates that selected [gotenberg.PdfEngine] modules
 // actually exist.
@@ -201,6 +426,31 @@ func (mod *PdfEngines) Validate() error {
 	findNonExistingEngines(mod.writeMetadataNames)
 	findNonExistingEngines(mod.encryptNames)
 	findNonExistingEngines(mod.embedNames)
+	findNonExistingEngines(mod.rotateNames)
+	findNonExistingEngines(mod.watermarkNames)
+	findNonExistingEngines(mod.overlayNames)
+	findNonExistingEngines(mod.infoNames)
+	findNonExistingEngines(mod.optimizeNames)
+	findNonExistingEngines(mod.decryptNames)
+	findNonExistingEngines(mod.readBookmarksNames)
+	findNonExistingEngines(mod.writeBookmarksNames)
+	findNonExistingEngines(mod.fillFormNames)
+	findNonExistingEngines(mod.readFormFieldsNames)
+	findNonExistingEngines(mod.extractTextNames)
+	findNonExistingEngines(mod.listEmbedsNames)
+	findNonExistingEngines(mod.extractEmbedsNames)
+	findNonExistingEngines(mod.extractImagesNames)
+	findNonExistingEngines(mod.sanitizeNames)
+	findNonExistingEngines(mod.importImagesNames)
+	findNonExistingEngines(mod.rasterizeNames)
+	findNonExistingEngines(mod.imposeNames)
+	findNonExistingEngines(mod.pageEditNames)
+	findNonExistingEngines(mod.signNames)
+	findNonExistingEngines(mod.verifySignaturesNames)
+	findNonExistingEngines(mod.validateNames)
+	findNonExistingEngines(mod.repairNames)
+	findNonExistingEngines(mod.resizeNames)
+	findNonExistingEngines(mod.cropNames)
 
 	if len(nonExistingEngines) == 0 {
 		return nil
@@ -212,7 +462,7 @@ func (mod *PdfEngines) Validate() error {
 // SystemMessages returns one message with the selected [gotenberg.PdfEngine]
 // modules.
 func (mod *PdfEngines) SystemMessages() []string {
-	return []string{
+	messages := []string{
 		fmt.Sprintf("merge engines - %s", strings.Join(mod.mergeNames[:], " ")),
 		fmt.Sprintf("split engines - %s", strings.Join(mod.splitNames[:], " ")),
 		fmt.Sprintf("flatten engines - %s", strings.Join(mod.flattenNames[:], " ")),
@@ -220,7 +470,38 @@ func (mod *PdfEngines) SystemMessages() []string {
 		fmt.Sprintf("read metadata engines - %s", strings.Join(mod.readMetadataNames[:], " ")),
 		fmt.Sprintf("write metadata engines - %s", strings.Join(mod.writeMetadataNames[:], " ")),
 		fmt.Sprintf("encrypt engines - %s", strings.Join(mod.encryptNames[:], " ")),
+		fmt.Sprintf("rotate engines - %s", strings.Join(mod.rotateNames[:], " ")),
+		fmt.Sprintf("watermark engines - %s", strings.Join(mod.watermarkNames[:], " ")),
+		fmt.Sprintf("overlay engines - %s", strings.Join(mod.overlayNames[:], " ")),
+		fmt.Sprintf("info engines - %s", strings.Join(mod.infoNames[:], " ")),
+		fmt.Sprintf("optimize engines - %s", strings.Join(mod.optimizeNames[:], " ")),
+		fmt.Sprintf("decrypt engines - %s", strings.Join(mod.decryptNames[:], " ")),
+		fmt.Sprintf("read bookmarks engines - %s", strings.Join(mod.readBookmarksNames[:], " ")),
+		fmt.Sprintf("write bookmarks engines - %s", strings.Join(mod.writeBookmarksNames[:], " ")),
+		fmt.Sprintf("fill form engines - %s", strings.Join(mod.fillFormNames[:], " ")),
+		fmt.Sprintf("read form fields engines - %s", strings.Join(mod.readFormFieldsNames[:], " ")),
+		fmt.Sprintf("extract text engines - %s", strings.Join(mod.extractTextNames[:], " ")),
+		fmt.Sprintf("list embedded files engines - %s", strings.Join(mod.listEmbedsNames[:], " ")),
+		fmt.Sprintf("extract embedded files engines - %s", strings.Join(mod.extractEmbedsNames[:], " ")),
+		fmt.Sprintf("extract images engines - %s", strings.Join(mod.extractImagesNames[:], " ")),
+		fmt.Sprintf("sanitize engines - %s", strings.Join(mod.sanitizeNames[:], " ")),
+		fmt.Sprintf("import images engines - %s", strings.Join(mod.importImagesNames[:], " ")),
+		fmt.Sprintf("rasterize engines - %s", strings.Join(mod.rasterizeNames[:], " ")),
+		fmt.Sprintf("impose engines - %s", strings.Join(mod.imposeNames[:], " ")),
+		fmt.Sprintf("page edit engines - %s", strings.Join(mod.pageEditNames[:], " ")),
+		fmt.Sprintf("sign engines - %s", strings.Join(mod.signNames[:], " ")),
+		fmt.Sprintf("verify signatures engines - %s", strings.Join(mod.verifySignaturesNames[:], " ")),
+		fmt.Sprintf("validate engines - %s", strings.Join(mod.validateNames[:], " ")),
+		fmt.Sprintf("repair engines - %s", strings.Join(mod.repairNames[:], " ")),
+		fmt.Sprintf("resize engines - %s", strings.Join(mod.resizeNames[:], " ")),
+		fmt.Sprintf("crop engines - %s", strings.Join(mod.cropNames[:], " ")),
+	}
+
+	if len(mod.unavailableNames) > 0 {
+		messages = append(messages, fmt.Sprintf("unavailable engines - %s", strings.Join(mod.unavailableNames[:], " ")))
 	}
+
+	return messages
 }
 
 // PdfEngine returns a [gotenberg.PdfEngine].
@@ -248,6 +529,31 @@ func (mod *PdfEngines) PdfEngine() (gotenberg.PdfEngine, error) {
 		engines(mod.writeMetadataNames),
 		engines(mod.encryptNames),
 		engines(mod.embedNames),
+		engines(mod.rotateNames),
+		engines(mod.watermarkNames),
+		engines(mod.overlayNames),
+		engines(mod.infoNames),
+		engines(mod.optimizeNames),
+		engines(mod.decryptNames),
+		engines(mod.readBookmarksNames),
+		engines(mod.writeBookmarksNames),
+		engines(mod.fillFormNames),
+		engines(mod.readFormFieldsNames),
+		engines(mod.extractTextNames),
+		engines(mod.listEmbedsNames),
+		engines(mod.extractEmbedsNames),
+		engines(mod.extractImagesNames),
+		engines(mod.sanitizeNames),
+		engines(mod.importImagesNames),
+		engines(mod.rasterizeNames),
+		engines(mod.imposeNames),
+		engines(mod.pageEditNames),
+		engines(mod.signNames),
+		engines(mod.verifySignaturesNames),
+		engines(mod.validateNames),
+		engines(mod.repairNames),
+		engines(mod.resizeNames),
+		engines(mod.cropNames),
 	), nil
 }
 
@@ -264,16 +570,57 @@ func (mod *PdfEngines) Routes() ([]api.Route, error) {
 		return nil, fmt.Errorf("get pdf mod: %w", err)
 	}
 
-	return []api.Route{
-		mergeRoute(engine),
-		splitRoute(engine),
-		flattenRoute(engine),
-		convertRoute(engine),
-		readMetadataRoute(engine),
-		writeMetadataRoute(engine),
-		encryptRoute(engine),
-		embedRoute(engine),
-	}, nil
+	// A feature without any available engine (e.g., no pyHanko binary for
+	// the sign feature) does not expose its route.
+	candidates := []struct {
+		names []string
+		route api.Route
+	}{
+		{names: mod.mergeNames, route: mergeRoute(engine)},
+		{names: mod.splitNames, route: splitRoute(engine)},
+		{names: mod.flattenNames, route: flattenRoute(engine)},
+		{names: mod.convertNames, route: convertRoute(engine)},
+		{names: mod.readMetadataNames, route: readMetadataRoute(engine)},
+		{names: mod.writeMetadataNames, route: writeMetadataRoute(engine)},
+		{names: mod.encryptNames, route: encryptRoute(engine)},
+		{names: mod.embedNames, route: embedRoute(engine)},
+		{names: mod.rotateNames, route: rotateRoute(engine)},
+		{names: mod.watermarkNames, route: watermarkRoute(engine)},
+		{names: mod.overlayNames, route: overlayRoute(engine)},
+		{names: mod.infoNames, route: infoRoute(engine)},
+		{names: mod.optimizeNames, route: optimizeRoute(engine)},
+		{names: mod.decryptNames, route: decryptRoute(engine)},
+		{names: mod.readBookmarksNames, route: readBookmarksRoute(engine)},
+		{names: mod.writeBookmarksNames, route: writeBookmarksRoute(engine)},
+		{names: mod.fillFormNames, route: fillFormRoute(engine)},
+		{names: mod.readFormFieldsNames, route: readFormFieldsRoute(engine)},
+		{names: mod.extractTextNames, route: extractTextRoute(engine)},
+		{names: mod.listEmbedsNames, route: listEmbedsRoute(engine)},
+		{names: mod.extractEmbedsNames, route: extractEmbedsRoute(engine)},
+		{names: mod.extractImagesNames, route: extractImagesRoute(engine)},
+		{names: mod.sanitizeNames, route: sanitizeRoute(engine)},
+		{names: mod.importImagesNames, route: convertImagesRoute(engine)},
+		{names: mod.rasterizeNames, route: rasterizeRoute(engine)},
+		{names: mod.imposeNames, route: imposeRoute(engine)},
+		{names: mod.pageEditNames, route: pageEditRoute(engine)},
+		{names: mod.signNames, route: signRoute(engine)},
+		{names: mod.verifySignaturesNames, route: verifySignaturesRoute(engine)},
+		{names: mod.validateNames, route: validateRoute(engine)},
+		{names: mod.repairNames, route: repairRoute(engine)},
+		{names: mod.resizeNames, route: resizeRoute(engine)},
+		{names: mod.cropNames, route: cropRoute(engine)},
+	}
+
+	routes := make([]api.Route, 0, len(candidates))
+	for _, candidate := range candidates {
+		if len(candidate.names) == 0 {
+			continue
+		}
+
+		routes = append(routes, candidate.route)
+	}
+
+	return routes, nil
 }
 
 // Interface guards.
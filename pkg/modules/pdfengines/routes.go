@@ -1,11 +1,15 @@
 package pdfengines
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -101,6 +105,16 @@ func FormDataPdfFormats(form *api.FormData) gotenberg.PdfFormats {
 	}
 }
 
+// FormDataPdfFailOnNonCompliance tells from the form data whether a PDF
+// which does not conform to the requested formats must fail the request.
+func FormDataPdfFailOnNonCompliance(form *api.FormData) bool {
+	var failOnNonCompliance bool
+
+	form.Bool("failOnNonCompliance", &failOnNonCompliance, false)
+
+	return failOnNonCompliance
+}
+
 // FormDataPdfMetadata creates metadata object from the form data.
 func FormDataPdfMetadata(form *api.FormData, mandatory bool) map[string]interface{} {
 	var metadata map[string]interface{}
@@ -128,19 +142,66 @@ func FormDataPdfMetadata(form *api.FormData, mandatory bool) map[string]interfac
 	return metadata
 }
 
-// MergeStub merges given PDFs. If only one input PDF, it does nothing and
-// returns the corresponding input path.
-func MergeStub(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths []string) (string, error) {
+// FormDataPdfMergeBookmarks extracts the "bookmarksPerFile" form field,
+// which enables a top-level bookmark per input file when merging, and the
+// "bookmarkLabels" form field, a JSON object mapping filenames to the labels
+// of these bookmarks.
+func FormDataPdfMergeBookmarks(form *api.FormData) (bool, map[string]string) {
+	var (
+		enabled bool
+		labels  map[string]string
+	)
+
+	form.
+		Bool("bookmarksPerFile", &enabled, false).
+		Custom("bookmarkLabels", func(value string) error {
+			if len(value) > 0 {
+				err := json.Unmarshal([]byte(value), &labels)
+				if err != nil {
+					return fmt.Errorf("unmarshal bookmark labels: %w", err)
+				}
+			}
+			return nil
+		})
+
+	return enabled, labels
+}
+
+// MergeBookmarkLabels returns the label of the top-level bookmark of each
+// given file: its entry in the labels, keyed by filename, or its filename
+// without the extension.
+func MergeBookmarkLabels(paths []string, labels map[string]string) []string {
+	res := make([]string, len(paths))
+
+	for i, path := range paths {
+		filename := filepath.Base(path)
+
+		label, ok := labels[filename]
+		if !ok {
+			label = strings.TrimSuffix(filename, filepath.Ext(filename))
+		}
+
+		res[i] = label
+	}
+
+	return res
+}
+
+// MergeStub merges given PDFs. If only one input PDF and no bookmark labels,
+// it does nothing and returns the corresponding input path. Bookmark labels,
+// one per input PDF, create a top-level bookmark per input PDF in the
+// resulting PDF, under which its original outline is nested.
+func MergeStub(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths, bookmarkLabels []string) (string, error) {
 	if len(inputPaths) == 0 {
 		return "", errors.New("no input paths")
 	}
 
-	if len(inputPaths) == 1 {
+	if len(inputPaths) == 1 && len(bookmarkLabels) == 0 {
 		return inputPaths[0], nil
 	}
 
 	outputPath := ctx.GeneratePath(".pdf")
-	err := engine.Merge(ctx, ctx.Log(), inputPaths, outputPath)
+	err := mergeWithBookmarks(ctx, engine, inputPaths, bookmarkLabels, outputPath)
 	if err != nil {
 		return "", fmt.Errorf("merge %d PDFs: %w", len(inputPaths), err)
 	}
@@ -148,6 +209,78 @@ func MergeStub(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths []string
 	return outputPath, nil
 }
 
+// mergeWithBookmarks merges given PDFs and, if there are bookmark labels,
+// replaces the outline of the resulting PDF with a top-level bookmark per
+// input PDF.
+func mergeWithBookmarks(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths, bookmarkLabels []string, outputPath string) error {
+	if len(bookmarkLabels) == 0 {
+		return engine.Merge(ctx, ctx.Log(), inputPaths, outputPath)
+	}
+
+	if len(bookmarkLabels) != len(inputPaths) {
+		return fmt.Errorf("expected %d bookmark labels, got %d", len(inputPaths), len(bookmarkLabels))
+	}
+
+	bookmarks := make([]gotenberg.Bookmark, len(inputPaths))
+	page := 1
+
+	for i, inputPath := range inputPaths {
+		info, err := engine.Info(ctx, ctx.Log(), inputPath)
+		if err != nil {
+			return fmt.Errorf("get '%s' info: %w", inputPath, err)
+		}
+
+		children, err := engine.ReadBookmarks(ctx, ctx.Log(), inputPath)
+		if err != nil {
+			return fmt.Errorf("read '%s' bookmarks: %w", inputPath, err)
+		}
+
+		bookmarks[i] = gotenberg.Bookmark{
+			Title:    bookmarkLabels[i],
+			Page:     page,
+			Children: shiftBookmarks(children, page),
+		}
+
+		page += info.PageCount
+	}
+
+	err := engine.Merge(ctx, ctx.Log(), inputPaths, outputPath)
+	if err != nil {
+		return err
+	}
+
+	err = engine.WriteBookmarks(ctx, ctx.Log(), bookmarks, outputPath)
+	if err != nil {
+		return fmt.Errorf("write bookmarks: %w", err)
+	}
+
+	return nil
+}
+
+// shiftBookmarks moves the page targets of bookmarks to a document starting
+// at the given page. Bookmarks without a page target point to this first
+// page.
+func shiftBookmarks(bookmarks []gotenberg.Bookmark, firstPage int) []gotenberg.Bookmark {
+	if len(bookmarks) == 0 {
+		return nil
+	}
+
+	shifted := make([]gotenberg.Bookmark, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		shifted[i] = gotenberg.Bookmark{
+			Title:    bookmark.Title,
+			Page:     firstPage,
+			Children: shiftBookmarks(bookmark.Children, firstPage),
+		}
+
+		if bookmark.Page > 0 {
+			shifted[i].Page += bookmark.Page - 1
+		}
+	}
+
+	return shifted
+}
+
 // SplitPdfStub splits a list of PDF files based on [gotenberg.SplitMode].
 // It returns a list of output paths or the list of provided input paths if no
 // split requested.
@@ -215,6 +348,112 @@ func FlattenStub(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths []stri
 	return nil
 }
 
+// RepairedFilesHeader is the response header which lists the filenames of
+// the input PDFs repaired by the auto repair feature.
+const RepairedFilesHeader = "Gotenberg-Repaired-Files"
+
+// FormDataPdfAutoRepair tells from the form data whether to repair the input
+// PDFs and retry if an operation fails on them.
+func FormDataPdfAutoRepair(form *api.FormData) bool {
+	var autoRepair bool
+
+	form.Bool("autoRepair", &autoRepair, false)
+
+	return autoRepair
+}
+
+// RepairStub rebuilds the cross-reference table and recovers the objects of
+// each given PDF.
+func RepairStub(ctx *api.Context, engine gotenberg.PdfEngine, inputPaths []string) error {
+	for _, inputPath := range inputPaths {
+		err := engine.Repair(ctx, ctx.Log(), inputPath)
+		if err != nil {
+			return fmt.Errorf("repair '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// RetryWithRepairStub runs the given operation on the given PDFs. If the
+// operation fails with an error a damaged PDF may cause and auto repair is
+// enabled, it repairs these PDFs one at a time, retrying the operation after
+// each repair until it succeeds. It returns the paths of the repaired PDFs, if
+// any.
+func RetryWithRepairStub(ctx *api.Context, engine gotenberg.PdfEngine, autoRepair bool, inputPaths []string, operation func() error) ([]string, error) {
+	err := operation()
+	if err == nil || !autoRepair || !isDamageError(err) || ctx.Err() != nil {
+		return nil, err
+	}
+
+	ctx.Log().Warn(fmt.Sprintf("operation failed, repairing the PDFs one at a time before retrying: %s", err))
+
+	var repairedPaths []string
+	for _, inputPath := range inputPaths {
+		repairErr := engine.Repair(ctx, ctx.Log(), inputPath)
+		if repairErr != nil {
+			ctx.Log().Error(fmt.Sprintf("repair '%s': %s", filepath.Base(inputPath), repairErr))
+			return nil, err
+		}
+
+		ctx.Log().Info(fmt.Sprintf("'%s' repaired", filepath.Base(inputPath)))
+		repairedPaths = append(repairedPaths, inputPath)
+
+		err = operation()
+		if err == nil {
+			return repairedPaths, nil
+		}
+
+		if !isDamageError(err) || ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("retry after repair: %w", err)
+}
+
+// isDamageError tells whether a damaged PDF may cause the given error. It
+// excludes the errors about the arguments, the passwords or the features of
+// the PDF engines, as repairing the PDFs would not fix them.
+func isDamageError(err error) bool {
+	var invalidArgsErr *gotenberg.PdfEngineInvalidArgsError
+	if errors.As(err, &invalidArgsErr) {
+		return false
+	}
+
+	for _, target := range []error{
+		context.Canceled,
+		context.DeadlineExceeded,
+		gotenberg.ErrPdfInvalidPassword,
+		gotenberg.ErrPdfEngineMethodNotSupported,
+		gotenberg.ErrPdfSplitModeNotSupported,
+		gotenberg.ErrPdfFormatNotSupported,
+		gotenberg.ErrPdfEngineMetadataValueNotSupported,
+		gotenberg.ErrPdfEncryptionNotSupported,
+	} {
+		if errors.Is(err, target) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// addRepairedFilesHeader adds the [RepairedFilesHeader] to the response if
+// some input PDFs were repaired.
+func addRepairedFilesHeader(c echo.Context, repairedPaths []string) {
+	if len(repairedPaths) == 0 {
+		return
+	}
+
+	filenames := make([]string, len(repairedPaths))
+	for i, repairedPath := range repairedPaths {
+		filenames[i] = filepath.Base(repairedPath)
+	}
+
+	c.Response().Header().Set(RepairedFilesHeader, strings.Join(filenames, ", "))
+}
+
 // ConvertStub transforms a given PDF to the specified formats defined in
 // [gotenberg.PdfFormats]. If no format, it does nothing and returns the input
 // paths.
@@ -237,6 +476,46 @@ func ConvertStub(ctx *api.Context, engine gotenberg.PdfEngine, formats gotenberg
 	return outputPaths, nil
 }
 
+// ValidateComplianceStub validates PDF files against the conformance profiles
+// of the specified formats defined in [gotenberg.PdfFormats]. If not
+// requested or no format, it does nothing. It returns an error if a PDF file
+// does not conform.
+func ValidateComplianceStub(ctx *api.Context, engine gotenberg.PdfEngine, failOnNonCompliance bool, formats gotenberg.PdfFormats, inputPaths []string) error {
+	zeroValued := gotenberg.PdfFormats{}
+	if !failOnNonCompliance || formats == zeroValued {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		reports, err := engine.ValidateCompliance(ctx, ctx.Log(), formats, inputPath)
+		if errors.Is(err, gotenberg.ErrPdfEngineMethodNotSupported) {
+			return api.WrapError(
+				fmt.Errorf("validate '%s': %w", inputPath, err),
+				api.NewSentinelHttpError(http.StatusBadRequest, "The PDF engines cannot validate the PDF compliance; remove the 'failOnNonCompliance' form field"),
+			)
+		}
+		if err != nil {
+			return fmt.Errorf("validate '%s': %w", inputPath, err)
+		}
+
+		for _, report := range reports {
+			if report.Compliant {
+				continue
+			}
+
+			return api.WrapError(
+				fmt.Errorf("'%s' does not conform to '%s'", inputPath, report.Profile),
+				api.NewSentinelHttpError(
+					http.StatusUnprocessableEntity,
+					fmt.Sprintf("The PDF does not conform to the '%s' (%d rule violation(s))", report.Profile, len(report.Violations)),
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
 // WriteMetadataStub writes the metadata into PDF files. If no metadata, it
 // does nothing.
 func WriteMetadataStub(ctx *api.Context, engine gotenberg.PdfEngine, metadata map[string]interface{}, inputPaths []string) error {
@@ -262,180 +541,2529 @@ func FormDataPdfEmbeds(form *api.FormData) []string {
 	return embedPaths
 }
 
-// FormDataPdfEncrypt extracts encryption parameters from form data.
-func FormDataPdfEncrypt(form *api.FormData) (userPassword, ownerPassword string) {
-	form.String("userPassword", &userPassword, "")
-	form.String("ownerPassword", &ownerPassword, "")
-	return userPassword, ownerPassword
-}
+// FormDataPdfEncrypt extracts encryption parameters from form data. Without
+// any "allow*" form field, the PDF engine applies its default permissions;
+// otherwise, the missing permissions default to true.
+func FormDataPdfEncrypt(form *api.FormData) gotenberg.EncryptOptions {
+	var (
+		options     gotenberg.EncryptOptions
+		permissions gotenberg.PdfPermissions
+		customized  bool
+	)
+
+	allow := func(target *bool) func(value string) error {
+		return func(value string) error {
+			if value == "" {
+				*target = true
+				return nil
+			}
+
+			boolValue, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+
+			*target = boolValue
+			customized = true
+			return nil
+		}
+	}
+
+	form.
+		String("userPassword", &options.UserPassword, "").
+		String("ownerPassword", &options.OwnerPassword, "").
+		Custom("encryptionAlgorithm", func(value string) error {
+			switch value {
+			case "", gotenberg.EncryptionAlgorithmAes256, gotenberg.EncryptionAlgorithmAes128, gotenberg.EncryptionAlgorithmRc4128, gotenberg.EncryptionAlgorithmRc440:
+				options.Algorithm = value
+				return nil
+			default:
+				return fmt.Errorf("wrong value, expected either '%s', '%s', '%s' or '%s'", gotenberg.EncryptionAlgorithmAes256, gotenberg.EncryptionAlgorithmAes128, gotenberg.EncryptionAlgorithmRc4128, gotenberg.EncryptionAlgorithmRc440)
+			}
+		}).
+		Custom("allowPrint", allow(&permissions.Print)).
+		Custom("allowPrintHighQuality", allow(&permissions.PrintHighQuality)).
+		Custom("allowModify", allow(&permissions.Modify)).
+		Custom("allowCopy", allow(&permissions.Copy)).
+		Custom("allowAnnotate", allow(&permissions.Annotate)).
+		Custom("allowFillForms", allow(&permissions.FillForms)).
+		Custom("allowAssemble", allow(&permissions.Assemble)).
+		Custom("allowAccessibility", allow(&permissions.Accessibility))
+
+	if customized {
+		options.Permissions = &permissions
+	}
+
+	return options
+}
+
+// EncryptPdfStub adds password protection to PDF files. If no user password,
+// it does nothing.
+func EncryptPdfStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.EncryptOptions, inputPaths []string) error {
+	if options.UserPassword == "" {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Encrypt(ctx, ctx.Log(), options, inputPath)
+		if err != nil {
+			return fmt.Errorf("encrypt PDF '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfSign creates a [gotenberg.SignOptions] from the form data. If
+// not mandatory, signing must be requested with the "sign" form field;
+// otherwise, it returns nil. As the PDF engines cannot sign an encrypted PDF,
+// it rejects signing along with the "userPassword" form field.
+func FormDataPdfSign(form *api.FormData, mandatory bool) *gotenberg.SignOptions {
+	var (
+		sign         bool
+		userPassword string
+		options      gotenberg.SignOptions
+	)
+
+	form.
+		Bool("sign", &sign, mandatory).
+		String("userPassword", &userPassword, "").
+		Custom("sign", func(value string) error {
+			if !mandatory && sign && userPassword != "" {
+				return errors.New("cannot sign an encrypted PDF, remove the 'userPassword' form field")
+			}
+
+			return nil
+		}).
+		FieldPaths(api.SignatureKeysFormField, &options.KeyPaths).
+		String("signatureFieldName", &options.FieldName, "").
+		Custom("signaturePage", func(value string) error {
+			options.Page = 1
+			if value == "" {
+				return nil
+			}
+
+			page, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+
+			if page < 1 {
+				return errors.New("value must be greater than 0")
+			}
+
+			options.Page = page
+			return nil
+		}).
+		Custom("signatureRect", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			parts := strings.Split(value, ",")
+			if len(parts) != 4 {
+				return errors.New("wrong value, expected 'x1,y1,x2,y2'")
+			}
+
+			for i, part := range parts {
+				coordinate, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+				if err != nil {
+					return err
+				}
+				options.Rect[i] = coordinate
+			}
+
+			if options.Rect[2] <= options.Rect[0] || options.Rect[3] <= options.Rect[1] {
+				return errors.New("wrong value, expected the lower left corner (x1,y1) before the upper right corner (x2,y2)")
+			}
+
+			options.Visible = true
+			return nil
+		}).
+		String("signatureReason", &options.Reason, "").
+		String("signatureLocation", &options.Location, "").
+		String("signatureContactInfo", &options.ContactInfo, "").
+		Custom("signatureTimestampUrl", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			u, err := url.Parse(value)
+			if err != nil {
+				return err
+			}
+
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return errors.New("wrong value, expected an HTTP or HTTPS URL")
+			}
+
+			options.TimestampUrl = value
+			return nil
+		}).
+		String("signaturePassphrase", &options.Passphrase, "")
+
+	if !sign && !mandatory {
+		return nil
+	}
+
+	return &options
+}
+
+// SignStub digitally signs PDF files. If no options, it does nothing. It must
+// be the last operation on the PDF files, as any later change invalidates the
+// signatures.
+func SignStub(ctx *api.Context, engine gotenberg.PdfEngine, options *gotenberg.SignOptions, inputPaths []string) error {
+	if options == nil {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Sign(ctx, ctx.Log(), *options, inputPath)
+		if errors.Is(err, gotenberg.ErrPdfEngineMethodNotSupported) {
+			return api.WrapError(
+				fmt.Errorf("sign PDF '%s': %w", inputPath, err),
+				api.NewSentinelHttpError(http.StatusBadRequest, "The PDF engines cannot sign the PDF; remove the 'sign' form field"),
+			)
+		}
+		if err != nil {
+			return fmt.Errorf("sign PDF '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// EmbedFilesStub embeds files into PDF files.
+func EmbedFilesStub(ctx *api.Context, engine gotenberg.PdfEngine, embedPaths []string, inputPaths []string) error {
+	if len(embedPaths) == 0 {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.EmbedFiles(ctx, ctx.Log(), embedPaths, inputPath)
+		if err != nil {
+			return fmt.Errorf("embed files into PDF '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfRotate extracts the rotation angle and the pages to rotate (see
+// [gotenberg.ParsePageRanges]) from the form data. If the angle is not
+// mandatory and not present, it returns 0.
+func FormDataPdfRotate(form *api.FormData, mandatory bool) (angle int, pages string) {
+	rotateAngleFunc := func(value string) error {
+		if value == "" {
+			return nil
+		}
+
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+
+		if intValue != 90 && intValue != 180 && intValue != 270 {
+			return errors.New("wrong value, expected either 90, 180 or 270")
+		}
+
+		angle = intValue
+		return nil
+	}
+
+	if mandatory {
+		form.MandatoryCustom("rotateAngle", func(value string) error {
+			return rotateAngleFunc(value)
+		})
+	} else {
+		form.Custom("rotateAngle", func(value string) error {
+			return rotateAngleFunc(value)
+		})
+	}
+
+	form.Custom("rotatePages", func(value string) error {
+		if strings.TrimSpace(value) == "" {
+			return nil
+		}
+
+		_, err := gotenberg.ParsePageRanges(value)
+		if err != nil {
+			return err
+		}
+
+		pages = strings.Join(strings.Fields(value), "")
+		return nil
+	})
+
+	return angle, pages
+}
+
+// RotateStub rotates the pages of PDF files. If the angle is 0, it does
+// nothing.
+func RotateStub(ctx *api.Context, engine gotenberg.PdfEngine, angle int, pages string, inputPaths []string) error {
+	if angle == 0 {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Rotate(ctx, ctx.Log(), angle, pages, inputPath)
+		if err != nil {
+			return fmt.Errorf("rotate '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfResize creates a [gotenberg.ResizeOptions] from the form data:
+// either a paper size with the "paperWidth" and "paperHeight" form fields, or
+// a scale factor with the "scale" form field. If there is none, it returns a
+// zero-valued [gotenberg.ResizeOptions].
+func FormDataPdfResize(form *api.FormData) gotenberg.ResizeOptions {
+	var options gotenberg.ResizeOptions
+
+	form.
+		Inches("paperWidth", &options.PaperWidth, 0).
+		Inches("paperHeight", &options.PaperHeight, 0).
+		Custom("paperHeight", func(value string) error {
+			if options.PaperWidth < 0 || options.PaperHeight < 0 {
+				return errors.New("wrong value, expected a positive paper size")
+			}
+
+			if (options.PaperWidth > 0) != (options.PaperHeight > 0) {
+				return errors.New("wrong value, expected both 'paperWidth' and 'paperHeight' form fields")
+			}
+
+			return nil
+		}).
+		Custom("scale", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			if options.PaperWidth > 0 {
+				return errors.New("wrong value, expected either a paper size or a scale factor")
+			}
+
+			floatValue, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+
+			if floatValue <= 0 {
+				return errors.New("value is inferior or equal to 0")
+			}
+
+			options.Scale = floatValue
+			return nil
+		}).
+		Custom("resizePages", func(value string) error {
+			options.Pages = strings.Join(strings.Fields(value), "")
+			return nil
+		})
+
+	if options.PaperWidth <= 0 && options.Scale == 0 {
+		return gotenberg.ResizeOptions{}
+	}
+
+	return options
+}
+
+// FormDataPdfNormalizePaperSize creates a [gotenberg.ResizeOptions] from the
+// "normalizePaperSize" form field, which resizes all the pages to the paper
+// size of the "paperWidth" and "paperHeight" form fields (default to US
+// Letter). If disabled, it returns a zero-valued [gotenberg.ResizeOptions].
+func FormDataPdfNormalizePaperSize(form *api.FormData) gotenberg.ResizeOptions {
+	var (
+		normalize bool
+		options   gotenberg.ResizeOptions
+	)
+
+	form.
+		Bool("normalizePaperSize", &normalize, false).
+		Inches("paperWidth", &options.PaperWidth, 8.5).
+		Inches("paperHeight", &options.PaperHeight, 11).
+		Custom("paperHeight", func(value string) error {
+			if normalize && (options.PaperWidth <= 0 || options.PaperHeight <= 0) {
+				return errors.New("wrong value, expected a positive paper size")
+			}
+
+			return nil
+		})
+
+	if !normalize {
+		return gotenberg.ResizeOptions{}
+	}
+
+	return options
+}
+
+// ResizeStub resizes the pages of PDF files. If no options, it does nothing.
+func ResizeStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.ResizeOptions, inputPaths []string) error {
+	zeroValued := gotenberg.ResizeOptions{}
+	if options == zeroValued {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Resize(ctx, ctx.Log(), options, inputPath)
+		if err != nil {
+			return fmt.Errorf("resize '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfCrop creates a [gotenberg.CropOptions] from the "cropBox",
+// "trimBox" and "bleedBox" form fields. Each one holds the margins of the
+// page box, the same way as the CSS margin shorthand property (e.g., "1cm" or
+// "0.5in 1in").
+func FormDataPdfCrop(form *api.FormData) gotenberg.CropOptions {
+	var options gotenberg.CropOptions
+
+	boxFunc := func(target **gotenberg.BoxMargins) func(value string) error {
+		return func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			margins, err := parseBoxMargins(value)
+			if err != nil {
+				return err
+			}
+
+			*target = &margins
+			return nil
+		}
+	}
+
+	form.
+		Custom("cropBox", boxFunc(&options.CropBox)).
+		Custom("trimBox", boxFunc(&options.TrimBox)).
+		Custom("bleedBox", boxFunc(&options.BleedBox)).
+		Custom("cropPages", func(value string) error {
+			options.Pages = strings.Join(strings.Fields(value), "")
+			return nil
+		})
+
+	return options
+}
+
+// parseBoxMargins parses one to four margins, with or without units, the
+// same way as the CSS margin shorthand property.
+func parseBoxMargins(value string) (gotenberg.BoxMargins, error) {
+	fields := strings.Fields(value)
+	if len(fields) > 4 {
+		return gotenberg.BoxMargins{}, errors.New("wrong value, expected from one to four margins")
+	}
+
+	margins := make([]float64, len(fields))
+	for i, field := range fields {
+		margin, err := api.ParseInches(field)
+		if err != nil {
+			return gotenberg.BoxMargins{}, err
+		}
+
+		if margin < 0 {
+			return gotenberg.BoxMargins{}, errors.New("wrong value, expected positive margins")
+		}
+
+		margins[i] = margin
+	}
+
+	switch len(margins) {
+	case 1:
+		return gotenberg.BoxMargins{Top: margins[0], Right: margins[0], Bottom: margins[0], Left: margins[0]}, nil
+	case 2:
+		return gotenberg.BoxMargins{Top: margins[0], Right: margins[1], Bottom: margins[0], Left: margins[1]}, nil
+	case 3:
+		return gotenberg.BoxMargins{Top: margins[0], Right: margins[1], Bottom: margins[2], Left: margins[1]}, nil
+	default:
+		return gotenberg.BoxMargins{Top: margins[0], Right: margins[1], Bottom: margins[2], Left: margins[3]}, nil
+	}
+}
+
+// CropStub sets the page boxes of PDF files. If no page box, it does
+// nothing.
+func CropStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.CropOptions, inputPaths []string) error {
+	if options.CropBox == nil && options.TrimBox == nil && options.BleedBox == nil {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Crop(ctx, ctx.Log(), options, inputPath)
+		if err != nil {
+			return fmt.Errorf("crop '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfWatermark creates a [gotenberg.Watermark] from the form data.
+// The watermark is either the "watermarkText" form field value, or an image
+// or a PDF uploaded with the "watermark" form field name. If not mandatory and
+// there is no watermark, it returns a zero-valued [gotenberg.Watermark].
+func FormDataPdfWatermark(form *api.FormData, mandatory bool) gotenberg.Watermark {
+	var (
+		watermark gotenberg.Watermark
+		filePaths []string
+	)
+
+	form.FieldPaths(api.WatermarkFormField, &filePaths)
+
+	unitFloatFunc := func(value string, target *float64) error {
+		if value == "" {
+			return nil
+		}
+
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+
+		if floatValue <= 0 {
+			return errors.New("value is inferior or equal to 0")
+		}
+
+		if floatValue > 1 {
+			return errors.New("value is superior to 1")
+		}
+
+		*target = floatValue
+		return nil
+	}
+
+	form.
+		Custom("watermarkText", func(value string) error {
+			if value != "" && len(filePaths) > 0 {
+				return errors.New("cannot be used alongside a watermark file")
+			}
+
+			if value != "" {
+				watermark.Source = gotenberg.WatermarkSourceText
+				watermark.Expression = value
+				return nil
+			}
+
+			if len(filePaths) > 1 {
+				return errors.New("only one watermark file is allowed")
+			}
+
+			if len(filePaths) == 1 {
+				switch strings.ToLower(filepath.Ext(filePaths[0])) {
+				case ".pdf":
+					watermark.Source = gotenberg.WatermarkSourcePdf
+				case ".png", ".jpg", ".jpeg", ".tif", ".tiff", ".webp":
+					watermark.Source = gotenberg.WatermarkSourceImage
+				default:
+					return fmt.Errorf("watermark file '%s' is neither a PDF nor an image", filepath.Base(filePaths[0]))
+				}
+				watermark.Expression = filePaths[0]
+				return nil
+			}
+
+			if mandatory {
+				return errors.New("either a text or a watermark file is required")
+			}
+
+			return nil
+		}).
+		Custom("watermarkPages", func(value string) error {
+			watermark.Pages = strings.Join(strings.Fields(value), "")
+			return nil
+		}).
+		Custom("watermarkOpacity", func(value string) error {
+			return unitFloatFunc(value, &watermark.Opacity)
+		}).
+		Custom("watermarkScale", func(value string) error {
+			return unitFloatFunc(value, &watermark.Scale)
+		}).
+		Custom("watermarkRotation", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			floatValue, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return err
+			}
+
+			if floatValue < -180 || floatValue > 180 {
+				return errors.New("value is not between -180 and 180")
+			}
+
+			watermark.Rotation = floatValue
+			return nil
+		}).
+		Custom("watermarkPosition", func(value string) error {
+			switch value {
+			case "", "center", "top-left", "top-center", "top-right", "left", "right", "bottom-left", "bottom-center", "bottom-right":
+				watermark.Position = value
+				return nil
+			default:
+				return errors.New("wrong value, expected either 'center', 'top-left', 'top-center', 'top-right', 'left', 'right', 'bottom-left', 'bottom-center' or 'bottom-right'")
+			}
+		}).
+		Bool("watermarkForeground", &watermark.Foreground, false)
+
+	return watermark
+}
+
+// WatermarkStub applies a watermark to PDF files. If no watermark, it does
+// nothing.
+func WatermarkStub(ctx *api.Context, engine gotenberg.PdfEngine, watermark gotenberg.Watermark, inputPaths []string) error {
+	if watermark.Source == "" {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Watermark(ctx, ctx.Log(), watermark, inputPath)
+		if err != nil {
+			return fmt.Errorf("watermark '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfOverlays creates a list of [gotenberg.Overlay] from the form
+// data. The PDFs are uploaded with the "underlay" and "overlay" form field
+// names, while the "underlayMode" and "overlayMode" form fields set how their
+// pages are laid on the target pages. Underlays come first. If not mandatory
+// and there is no overlay nor underlay, it returns an empty list.
+func FormDataPdfOverlays(form *api.FormData, mandatory bool) []gotenberg.Overlay {
+	var (
+		overlays      []gotenberg.Overlay
+		underlayPaths []string
+		overlayPaths  []string
+	)
+
+	form.
+		FieldPaths(api.UnderlayFormField, &underlayPaths).
+		FieldPaths(api.OverlayFormField, &overlayPaths)
+
+	modeFunc := func(value string, filePaths []string, underlay bool) error {
+		if len(filePaths) == 0 {
+			return nil
+		}
+
+		if len(filePaths) > 1 {
+			return errors.New("only one file is allowed")
+		}
+
+		if strings.ToLower(filepath.Ext(filePaths[0])) != ".pdf" {
+			return fmt.Errorf("file '%s' is not a PDF", filepath.Base(filePaths[0]))
+		}
+
+		if value == "" {
+			value = gotenberg.OverlayModeRepeat
+		}
+
+		switch value {
+		case gotenberg.OverlayModeFirst, gotenberg.OverlayModeSequence, gotenberg.OverlayModeRepeat:
+		default:
+			return fmt.Errorf("wrong value, expected either '%s', '%s' or '%s'", gotenberg.OverlayModeFirst, gotenberg.OverlayModeSequence, gotenberg.OverlayModeRepeat)
+		}
+
+		overlays = append(overlays, gotenberg.Overlay{
+			Path:     filePaths[0],
+			Underlay: underlay,
+			Mode:     value,
+		})
+
+		return nil
+	}
+
+	form.
+		Custom("underlayMode", func(value string) error {
+			return modeFunc(value, underlayPaths, true)
+		}).
+		Custom("overlayMode", func(value string) error {
+			err := modeFunc(value, overlayPaths, false)
+			if err != nil {
+				return err
+			}
+
+			if mandatory && len(overlays) == 0 {
+				return errors.New("either an overlay or an underlay file is required")
+			}
+
+			return nil
+		})
+
+	return overlays
+}
+
+// OverlayStub lays the pages of the given overlays over or under the pages of
+// PDF files. If no overlays, it does nothing.
+func OverlayStub(ctx *api.Context, engine gotenberg.PdfEngine, overlays []gotenberg.Overlay, inputPaths []string) error {
+	if len(overlays) == 0 {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		for _, overlay := range overlays {
+			err := engine.Overlay(ctx, ctx.Log(), overlay, inputPath)
+			if err != nil {
+				return fmt.Errorf("overlay '%s' with '%s': %w", inputPath, overlay.Path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfOptimize creates a [gotenberg.OptimizeOptions] from the form
+// data. The "compress" form field enables the lossless compression of the
+// streams and objects; it defaults to true if mandatory. The "compressImages"
+// form field recompresses the images without downsampling them. If there is
+// nothing to optimize, it returns a zero-valued [gotenberg.OptimizeOptions].
+// If not mandatory, it rejects the linearization along with the
+// "userPassword" or "sign" form fields, as the encryption and the signing
+// undo it.
+func FormDataPdfOptimize(form *api.FormData, mandatory bool) gotenberg.OptimizeOptions {
+	var (
+		compress     bool
+		sign         bool
+		userPassword string
+		options      gotenberg.OptimizeOptions
+	)
+
+	form.
+		Bool("compress", &compress, mandatory).
+		Bool("compressImages", &options.CompressImages, false).
+		Bool("removeDuplicates", &options.RemoveDuplicates, false).
+		Bool("linearize", &options.Linearize, false).
+		String("userPassword", &userPassword, "").
+		Custom("sign", func(value string) error {
+			// The "sign" form field is validated with the signing options.
+			sign, _ = strconv.ParseBool(value)
+			return nil
+		}).
+		Custom("linearize", func(value string) error {
+			if !mandatory && options.Linearize && (userPassword != "" || sign) {
+				return errors.New("cannot linearize an encrypted or signed PDF, remove the 'userPassword' and 'sign' form fields")
+			}
+
+			return nil
+		})
+
+	options.CompressStreams = compress
+	options.ObjectStreams = compress
+
+	return options
+}
+
+// OptimizeStub reduces the size of PDF files. If there is nothing to
+// optimize, it does nothing. As any later rewrite of the PDF files undoes the
+// linearization, it leaves it to [LinearizeStub].
+//
+// No PDF engine supports every option (e.g., QPDF does not remove duplicate
+// resources, while pdfcpu does not compress images), so the lossy compression
+// of the images happens in a second pass.
+func OptimizeStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.OptimizeOptions, inputPaths []string) error {
+	imagesOptions := gotenberg.OptimizeOptions{CompressImages: options.CompressImages}
+	options.CompressImages = false
+	options.Linearize = false
+
+	zeroValued := gotenberg.OptimizeOptions{}
+	for _, inputPath := range inputPaths {
+		for _, passOptions := range []gotenberg.OptimizeOptions{options, imagesOptions} {
+			if passOptions == zeroValued {
+				continue
+			}
+
+			err := engine.Optimize(ctx, ctx.Log(), passOptions, inputPath)
+			if err != nil {
+				return fmt.Errorf("optimize '%s': %w", inputPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// LinearizeStub optimizes PDF files for fast web view. If the linearization
+// is not requested, it does nothing. It must be the last rewrite of the PDF
+// files.
+func LinearizeStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.OptimizeOptions, inputPaths []string) error {
+	if !options.Linearize {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Optimize(ctx, ctx.Log(), gotenberg.OptimizeOptions{Linearize: true}, inputPath)
+		if err != nil {
+			return fmt.Errorf("linearize '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfSanitize creates a [gotenberg.SanitizeOptions] from the
+// "sanitize" form field, a comma-separated list of categories among
+// "javascript", "launchActions", "embeddedFiles", "xfa" and "annotations", or
+// "all" for every category.
+func FormDataPdfSanitize(form *api.FormData, mandatory bool) gotenberg.SanitizeOptions {
+	var options gotenberg.SanitizeOptions
+
+	sanitizeFunc := func(value string) error {
+		if value == "" {
+			return nil
+		}
+
+		for _, category := range strings.Split(value, ",") {
+			category = strings.TrimSpace(category)
+			switch category {
+			case "all":
+				options = gotenberg.SanitizeOptions{
+					JavaScript:    true,
+					LaunchActions: true,
+					EmbeddedFiles: true,
+					Xfa:           true,
+					Annotations:   true,
+				}
+			case "javascript":
+				options.JavaScript = true
+			case "launchActions":
+				options.LaunchActions = true
+			case "embeddedFiles":
+				options.EmbeddedFiles = true
+			case "xfa":
+				options.Xfa = true
+			case "annotations":
+				options.Annotations = true
+			default:
+				return fmt.Errorf("wrong category '%s', expected 'all', 'javascript', 'launchActions', 'embeddedFiles', 'xfa' or 'annotations'", category)
+			}
+		}
+
+		return nil
+	}
+
+	if mandatory {
+		form.MandatoryCustom("sanitize", func(value string) error {
+			return sanitizeFunc(value)
+		})
+	} else {
+		form.Custom("sanitize", func(value string) error {
+			return sanitizeFunc(value)
+		})
+	}
+
+	return options
+}
+
+// SanitizeStub removes active or hidden content from PDF files. If no
+// categories, it does nothing.
+func SanitizeStub(ctx *api.Context, engine gotenberg.PdfEngine, options gotenberg.SanitizeOptions, inputPaths []string) error {
+	zeroValued := gotenberg.SanitizeOptions{}
+	if options == zeroValued {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		err := engine.Sanitize(ctx, ctx.Log(), options, inputPath)
+		if err != nil {
+			return fmt.Errorf("sanitize '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+var pageSizeRegexp = regexp.MustCompile(`^([ABC](10|[0-9])|Letter|Legal|Ledger|Tabloid)$`)
+
+// FormDataPdfImportImages creates a [gotenberg.ImportImagesOptions] from the
+// form data.
+func FormDataPdfImportImages(form *api.FormData) gotenberg.ImportImagesOptions {
+	var options gotenberg.ImportImagesOptions
+
+	form.
+		Custom("pageSize", func(value string) error {
+			if value != "" && !pageSizeRegexp.MatchString(value) {
+				return errors.New("wrong value, expected an ISO A, B or C size (e.g., 'A4'), 'Letter', 'Legal', 'Ledger' or 'Tabloid'")
+			}
+			options.PageSize = value
+			return nil
+		}).
+		Bool("landscape", &options.Landscape, false).
+		Bool("fit", &options.Fit, false).
+		Bool("center", &options.Center, false).
+		Custom("dpi", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			dpi, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+
+			if dpi < 1 {
+				return errors.New("value must be greater than 0")
+			}
+
+			options.Dpi = dpi
+			return nil
+		})
+
+	return options
+}
+
+// FormDataPdfPasswords extracts the passwords of encrypted input PDFs from the
+// "passwords" form field, a JSON object mapping filenames to passwords.
+func FormDataPdfPasswords(form *api.FormData) map[string]string {
+	var passwords map[string]string
+
+	form.Custom("passwords", func(value string) error {
+		if len(value) > 0 {
+			err := json.Unmarshal([]byte(value), &passwords)
+			if err != nil {
+				return fmt.Errorf("unmarshal passwords: %w", err)
+			}
+		}
+		return nil
+	})
+
+	return passwords
+}
+
+// DecryptStub removes the password protection of the PDF files with an
+// entry in the given passwords, keyed by filename. If no passwords, it does
+// nothing.
+func DecryptStub(ctx *api.Context, engine gotenberg.PdfEngine, passwords map[string]string, inputPaths []string) error {
+	if len(passwords) == 0 {
+		return nil
+	}
+
+	for _, inputPath := range inputPaths {
+		password, ok := passwords[filepath.Base(inputPath)]
+		if !ok {
+			continue
+		}
+
+		err := engine.Decrypt(ctx, ctx.Log(), inputPath, password)
+		if err != nil {
+			return fmt.Errorf("decrypt '%s': %w", inputPath, err)
+		}
+	}
+
+	return nil
+}
+
+// FormDataPdfBookmarks creates a list of [gotenberg.Bookmark] from the
+// "bookmarks" form field, a JSON array of entries with a title, a page number
+// and optional children. An empty array removes the outline.
+func FormDataPdfBookmarks(form *api.FormData, mandatory bool) []gotenberg.Bookmark {
+	var bookmarks []gotenberg.Bookmark
+
+	var validate func(items []gotenberg.Bookmark) error
+	validate = func(items []gotenberg.Bookmark) error {
+		for _, item := range items {
+			if item.Title == "" {
+				return errors.New("bookmark title is required")
+			}
+			if item.Page < 1 {
+				return fmt.Errorf("bookmark '%s' page must be greater than 0", item.Title)
+			}
+			err := validate(item.Children)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	bookmarksFunc := func(value string) error {
+		if len(value) > 0 {
+			err := json.Unmarshal([]byte(value), &bookmarks)
+			if err != nil {
+				return fmt.Errorf("unmarshal bookmarks: %w", err)
+			}
+		}
+		return validate(bookmarks)
+	}
+
+	if mandatory {
+		form.MandatoryCustom("bookmarks", func(value string) error {
+			return bookmarksFunc(value)
+		})
+	} else {
+		form.Custom("bookmarks", func(value string) error {
+			return bookmarksFunc(value)
+		})
+	}
+
+	return bookmarks
+}
+
+// FormDataPdfFormFields extracts the values of AcroForm fields from the
+// "fields" form field, a JSON object mapping fully qualified field names to
+// values.
+func FormDataPdfFormFields(form *api.FormData, mandatory bool) map[string]interface{} {
+	var fields map[string]interface{}
+
+	fieldsFunc := func(value string) error {
+		if len(value) > 0 {
+			err := json.Unmarshal([]byte(value), &fields)
+			if err != nil {
+				return fmt.Errorf("unmarshal fields: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if mandatory {
+		form.MandatoryCustom("fields", func(value string) error {
+			return fieldsFunc(value)
+		})
+	} else {
+		form.Custom("fields", func(value string) error {
+			return fieldsFunc(value)
+		})
+	}
+
+	return fields
+}
+
+// mergeRoute returns an [api.Route] which can merge PDFs.
+func mergeRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/merge",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			pdfFormats := FormDataPdfFormats(form)
+			failOnNonCompliance := FormDataPdfFailOnNonCompliance(form)
+			metadata := FormDataPdfMetadata(form, false)
+			encryptOptions := FormDataPdfEncrypt(form)
+			signOptions := FormDataPdfSign(form, false)
+			embedPaths := FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := FormDataPdfRotate(form, false)
+			watermark := FormDataPdfWatermark(form, false)
+			overlays := FormDataPdfOverlays(form, false)
+			optimizeOptions := FormDataPdfOptimize(form, false)
+			passwords := FormDataPdfPasswords(form)
+			sanitizeOptions := FormDataPdfSanitize(form, false)
+			bookmarksPerFile, bookmarkLabels := FormDataPdfMergeBookmarks(form)
+			autoRepair := FormDataPdfAutoRepair(form)
+			normalizePaperSize := FormDataPdfNormalizePaperSize(form)
+
+			var inputPaths []string
+			var flatten bool
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Bool("flatten", &flatten, false).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = DecryptStub(ctx, engine, passwords, inputPaths)
+			if err != nil {
+				return fmt.Errorf("decrypt PDFs: %w", err)
+			}
+
+			var labels []string
+			if bookmarksPerFile {
+				labels = MergeBookmarkLabels(inputPaths, bookmarkLabels)
+			}
+
+			// As the engines do not tell which input PDF made the merge
+			// fail, the auto repair feature repairs them one at a time.
+			outputPath := ctx.GeneratePath(".pdf")
+			repairedPaths, err := RetryWithRepairStub(ctx, engine, autoRepair, inputPaths, func() error {
+				return mergeWithBookmarks(ctx, engine, inputPaths, labels, outputPath)
+			})
+			if err != nil {
+				return fmt.Errorf("merge PDFs: %w", err)
+			}
+
+			addRepairedFilesHeader(c, repairedPaths)
+
+			err = ResizeStub(ctx, engine, normalizePaperSize, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("normalize PDF paper size: %w", err)
+			}
+
+			err = SanitizeStub(ctx, engine, sanitizeOptions, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("sanitize PDF: %w", err)
+			}
+
+			err = RotateStub(ctx, engine, rotateAngle, rotatePages, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("rotate PDF: %w", err)
+			}
+
+			err = WatermarkStub(ctx, engine, watermark, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("watermark PDF: %w", err)
+			}
+
+			err = OverlayStub(ctx, engine, overlays, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("overlay PDF: %w", err)
+			}
+
+			err = OptimizeStub(ctx, engine, optimizeOptions, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("optimize PDF: %w", err)
+			}
+
+			outputPaths, err := ConvertStub(ctx, engine, pdfFormats, []string{outputPath})
+			if err != nil {
+				return fmt.Errorf("convert PDF: %w", err)
+			}
+
+			err = EmbedFilesStub(ctx, engine, embedPaths, outputPaths)
+			if err != nil {
+				return fmt.Errorf("embed files into PDFs: %w", err)
+			}
+
+			err = WriteMetadataStub(ctx, engine, metadata, outputPaths)
+			if err != nil {
+				return fmt.Errorf("write metadata: %w", err)
+			}
+
+			if flatten {
+				err = FlattenStub(ctx, engine, outputPaths)
+				if err != nil {
+					return fmt.Errorf("flatten PDFs: %w", err)
+				}
+			}
+
+			err = LinearizeStub(ctx, engine, optimizeOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("linearize PDFs: %w", err)
+			}
+
+			err = ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("validate PDFs compliance: %w", err)
+			}
+
+			err = EncryptPdfStub(ctx, engine, encryptOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("encrypt PDFs: %w", err)
+			}
+
+			err = SignStub(ctx, engine, signOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("sign PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// splitRoute returns an [api.Route] which can extract pages from a PDF.
+func splitRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/split",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			mode := FormDataPdfSplitMode(form, true)
+			pdfFormats := FormDataPdfFormats(form)
+			failOnNonCompliance := FormDataPdfFailOnNonCompliance(form)
+			metadata := FormDataPdfMetadata(form, false)
+			encryptOptions := FormDataPdfEncrypt(form)
+			signOptions := FormDataPdfSign(form, false)
+			embedPaths := FormDataPdfEmbeds(form)
+			rotateAngle, rotatePages := FormDataPdfRotate(form, false)
+			watermark := FormDataPdfWatermark(form, false)
+			overlays := FormDataPdfOverlays(form, false)
+			optimizeOptions := FormDataPdfOptimize(form, false)
+			passwords := FormDataPdfPasswords(form)
+			sanitizeOptions := FormDataPdfSanitize(form, false)
+			autoRepair := FormDataPdfAutoRepair(form)
+
+			var inputPaths []string
+			var flatten bool
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Bool("flatten", &flatten, false).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = DecryptStub(ctx, engine, passwords, inputPaths)
+			if err != nil {
+				return fmt.Errorf("decrypt PDFs: %w", err)
+			}
+
+			err = SanitizeStub(ctx, engine, sanitizeOptions, inputPaths)
+			if err != nil {
+				return fmt.Errorf("sanitize PDFs: %w", err)
+			}
+
+			err = RotateStub(ctx, engine, rotateAngle, rotatePages, inputPaths)
+			if err != nil {
+				return fmt.Errorf("rotate PDFs: %w", err)
+			}
+
+			err = WatermarkStub(ctx, engine, watermark, inputPaths)
+			if err != nil {
+				return fmt.Errorf("watermark PDFs: %w", err)
+			}
+
+			err = OverlayStub(ctx, engine, overlays, inputPaths)
+			if err != nil {
+				return fmt.Errorf("overlay PDFs: %w", err)
+			}
+
+			var outputPaths, repairedPaths []string
+			for _, inputPath := range inputPaths {
+				var paths []string
+				repaired, err := RetryWithRepairStub(ctx, engine, autoRepair, []string{inputPath}, func() error {
+					var splitErr error
+					paths, splitErr = SplitPdfStub(ctx, engine, mode, []string{inputPath})
+					return splitErr
+				})
+				if err != nil {
+					return fmt.Errorf("split PDFs: %w", err)
+				}
+
+				outputPaths = append(outputPaths, paths...)
+				repairedPaths = append(repairedPaths, repaired...)
+			}
+
+			addRepairedFilesHeader(c, repairedPaths)
+
+			err = OptimizeStub(ctx, engine, optimizeOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("optimize PDFs: %w", err)
+			}
+
+			convertOutputPaths, err := ConvertStub(ctx, engine, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("convert PDFs: %w", err)
+			}
+
+			err = EmbedFilesStub(ctx, engine, embedPaths, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("embed files into PDFs: %w", err)
+			}
+
+			err = WriteMetadataStub(ctx, engine, metadata, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("write metadata: %w", err)
+			}
+
+			if flatten {
+				err = FlattenStub(ctx, engine, convertOutputPaths)
+				if err != nil {
+					return fmt.Errorf("flatten PDFs: %w", err)
+				}
+			}
+
+			err = LinearizeStub(ctx, engine, optimizeOptions, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("linearize PDFs: %w", err)
+			}
+
+			err = ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("validate PDFs compliance: %w", err)
+			}
+
+			err = EncryptPdfStub(ctx, engine, encryptOptions, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("encrypt PDFs: %w", err)
+			}
+
+			err = SignStub(ctx, engine, signOptions, convertOutputPaths)
+			if err != nil {
+				return fmt.Errorf("sign PDFs: %w", err)
+			}
+
+			zeroValuedSplitMode := gotenberg.SplitMode{}
+			zeroValuedPdfFormats := gotenberg.PdfFormats{}
+			if mode != zeroValuedSplitMode && pdfFormats != zeroValuedPdfFormats {
+				// Rename the files to keep the split naming.
+				for i, convertOutputPath := range convertOutputPaths {
+					err = ctx.Rename(convertOutputPath, outputPaths[i])
+					if err != nil {
+						return fmt.Errorf("rename output path: %w", err)
+					}
+				}
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// flattenRoute returns an [api.Route] which can flatten PDFs.
+func flattenRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/flatten",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			autoRepair := FormDataPdfAutoRepair(form)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			var repairedPaths []string
+			for _, inputPath := range inputPaths {
+				repaired, err := RetryWithRepairStub(ctx, engine, autoRepair, []string{inputPath}, func() error {
+					return FlattenStub(ctx, engine, []string{inputPath})
+				})
+				if err != nil {
+					return fmt.Errorf("flatten PDFs: %w", err)
+				}
+
+				repairedPaths = append(repairedPaths, repaired...)
+			}
+
+			addRepairedFilesHeader(c, repairedPaths)
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// convertRoute returns an [api.Route] which can convert PDFs to a specific ODF
+// format.
+func convertRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/convert",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			pdfFormats := FormDataPdfFormats(form)
+			failOnNonCompliance := FormDataPdfFailOnNonCompliance(form)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			zeroValued := gotenberg.PdfFormats{}
+			if pdfFormats == zeroValued {
+				return api.WrapError(
+					errors.New("no PDF formats"),
+					api.NewSentinelHttpError(
+						http.StatusBadRequest,
+						"Invalid form data: either 'pdfa' or 'pdfua' form fields must be provided",
+					),
+				)
+			}
+
+			outputPaths, err := ConvertStub(ctx, engine, pdfFormats, inputPaths)
+			if err != nil {
+				return fmt.Errorf("convert PDFs: %w", err)
+			}
+
+			err = ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("validate PDFs compliance: %w", err)
+			}
+
+			if len(outputPaths) > 1 {
+				// If .zip archive, keep the original filename.
+				for i, inputPath := range inputPaths {
+					err = ctx.Rename(outputPaths[i], inputPath)
+					if err != nil {
+						return fmt.Errorf("rename output path: %w", err)
+					}
+					outputPaths[i] = inputPath
+				}
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// readMetadataRoute returns an [api.Route] which returns the metadata of PDFs.
+func readMetadataRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/metadata/read",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string]map[string]interface{}, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				metadata, err := engine.ReadMetadata(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("read metadata: %w", err)
+				}
+
+				res[filepath.Base(inputPath)] = metadata
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// infoRoute returns an [api.Route] which returns information about PDFs.
+func infoRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/info",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string]gotenberg.PdfInfo, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				info, err := engine.Info(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("get info: %w", err)
+				}
+
+				res[filepath.Base(inputPath)] = info
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// writeMetadataRoute returns an [api.Route] which can write metadata into
+// PDFs.
+func writeMetadataRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/metadata/write",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			metadata := FormDataPdfMetadata(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = WriteMetadataStub(ctx, engine, metadata, inputPaths)
+			if err != nil {
+				return fmt.Errorf("write metadata: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// encryptRoute returns an [api.Route] which can add password protection to PDFs.
+func encryptRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/encrypt",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			encryptOptions := FormDataPdfEncrypt(form)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				MandatoryString("userPassword", &encryptOptions.UserPassword).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = EncryptPdfStub(ctx, engine, encryptOptions, inputPaths)
+			if err != nil {
+				return fmt.Errorf("encrypt PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// embedRoute returns an [api.Route] which can add embedded files to PDFs.
+func embedRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/embed",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			embedPaths := FormDataPdfEmbeds(form)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = EmbedFilesStub(ctx, engine, embedPaths, inputPaths)
+			if err != nil {
+				return fmt.Errorf("embed files into PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// rotateRoute returns an [api.Route] which can rotate the pages of PDFs.
+func rotateRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/rotate",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			angle, pages := FormDataPdfRotate(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = RotateStub(ctx, engine, angle, pages, inputPaths)
+			if err != nil {
+				return fmt.Errorf("rotate PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// watermarkRoute returns an [api.Route] which can watermark PDFs.
+func watermarkRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/watermark",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			watermark := FormDataPdfWatermark(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = WatermarkStub(ctx, engine, watermark, inputPaths)
+			if err != nil {
+				return fmt.Errorf("watermark PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// overlayRoute returns an [api.Route] which can lay the pages of a PDF over
+// or under the pages of other PDFs.
+func overlayRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/overlay",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			overlays := FormDataPdfOverlays(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = OverlayStub(ctx, engine, overlays, inputPaths)
+			if err != nil {
+				return fmt.Errorf("overlay PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// optimizeRoute returns an [api.Route] which can reduce the size of PDFs.
+func optimizeRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/optimize",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			options := FormDataPdfOptimize(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = OptimizeStub(ctx, engine, options, inputPaths)
+			if err != nil {
+				return fmt.Errorf("optimize PDFs: %w", err)
+			}
+
+			err = LinearizeStub(ctx, engine, options, inputPaths)
+			if err != nil {
+				return fmt.Errorf("linearize PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// decryptRoute returns an [api.Route] which can remove the password
+// protection of PDFs.
+func decryptRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/decrypt",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			passwords := FormDataPdfPasswords(form)
+
+			var (
+				inputPaths []string
+				password   string
+			)
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				String("password", &password, "").
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			for _, inputPath := range inputPaths {
+				inputPassword, ok := passwords[filepath.Base(inputPath)]
+				if !ok {
+					inputPassword = password
+				}
+
+				err = engine.Decrypt(ctx, ctx.Log(), inputPath, inputPassword)
+				if err != nil {
+					return fmt.Errorf("decrypt '%s': %w", inputPath, err)
+				}
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// readBookmarksRoute returns an [api.Route] which returns the bookmarks of
+// PDFs.
+func readBookmarksRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/bookmarks/read",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string][]gotenberg.Bookmark, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				bookmarks, err := engine.ReadBookmarks(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("read bookmarks: %w", err)
+				}
+
+				res[filepath.Base(inputPath)] = bookmarks
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// writeBookmarksRoute returns an [api.Route] which can replace the bookmarks
+// of PDFs.
+func writeBookmarksRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/bookmarks/write",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			bookmarks := FormDataPdfBookmarks(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			for _, inputPath := range inputPaths {
+				err = engine.WriteBookmarks(ctx, ctx.Log(), bookmarks, inputPath)
+				if err != nil {
+					return fmt.Errorf("write bookmarks: %w", err)
+				}
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// fillFormRoute returns an [api.Route] which can fill the AcroForm fields of
+// PDFs.
+func fillFormRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/forms/fill",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			fields := FormDataPdfFormFields(form, true)
+
+			var inputPaths []string
+			var flatten bool
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Bool("flatten", &flatten, false).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			for _, inputPath := range inputPaths {
+				err = engine.FillForm(ctx, ctx.Log(), fields, inputPath)
+				if err != nil {
+					return fmt.Errorf("fill form of '%s': %w", inputPath, err)
+				}
+			}
+
+			if flatten {
+				err = FlattenStub(ctx, engine, inputPaths)
+				if err != nil {
+					return fmt.Errorf("flatten PDFs: %w", err)
+				}
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// readFormFieldsRoute returns an [api.Route] which returns the AcroForm
+// fields of PDFs.
+func readFormFieldsRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/forms/read",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string][]gotenberg.FormField, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				fields, err := engine.ReadFormFields(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("read form fields: %w", err)
+				}
+
+				res[filepath.Base(inputPath)] = fields
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// extractTextRoute returns an [api.Route] which can extract the text of PDFs,
+// either as JSON or as text files. In text files, pages are separated by form
+// feeds.
+func extractTextRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/text",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var (
+				inputPaths []string
+				pageRanges string
+				format     string
+			)
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				String("pageRanges", &pageRanges, "").
+				Custom("format", func(value string) error {
+					switch value {
+					case "", "json":
+						format = "json"
+					case "txt":
+						format = value
+					default:
+						return errors.New("wrong value, expected either 'json' or 'txt'")
+					}
+					return nil
+				}).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			texts := make(map[string][]gotenberg.PdfPageText, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				pageTexts, err := engine.ExtractText(ctx, ctx.Log(), pageRanges, inputPath)
+				if err != nil {
+					return fmt.Errorf("extract text from '%s': %w", inputPath, err)
+				}
+
+				texts[inputPath] = pageTexts
+			}
+
+			if format == "txt" {
+				outputPaths := make([]string, len(inputPaths))
+				for i, inputPath := range inputPaths {
+					pages := make([]string, len(texts[inputPath]))
+					for j, pageText := range texts[inputPath] {
+						pages[j] = pageText.Text
+					}
+
+					outputPaths[i] = fmt.Sprintf("%s.txt", strings.TrimSuffix(inputPath, filepath.Ext(inputPath)))
+					err = os.WriteFile(outputPaths[i], []byte(strings.Join(pages, "\f")), 0o600)
+					if err != nil {
+						return fmt.Errorf("write text file: %w", err)
+					}
+				}
+
+				err = ctx.AddOutputPaths(outputPaths...)
+				if err != nil {
+					return fmt.Errorf("add output paths: %w", err)
+				}
+
+				return nil
+			}
+
+			res := make(map[string][]gotenberg.PdfPageText, len(inputPaths))
+			for inputPath, pageTexts := range texts {
+				res[filepath.Base(inputPath)] = pageTexts
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// listEmbedsRoute returns an [api.Route] which can list the files attached to
+// PDFs.
+func listEmbedsRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/embeds/list",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string][]gotenberg.EmbeddedFile, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				embeds, err := engine.ListEmbeds(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("list embedded files: %w", err)
+				}
+
+				if embeds == nil {
+					embeds = []gotenberg.EmbeddedFile{}
+				}
+
+				res[filepath.Base(inputPath)] = embeds
+			}
+
+			err = c.JSON(http.StatusOK, res)
+			if err != nil {
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
+			}
+
+			return api.ErrNoOutputFile
+		},
+	}
+}
+
+// extractEmbedsRoute returns an [api.Route] which can extract the files
+// attached to PDFs.
+func extractEmbedsRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/embeds/extract",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			outputPaths, err := extractFiles(ctx, inputPaths, func(inputPath, outputDirPath string) ([]string, error) {
+				return engine.ExtractEmbeds(ctx, ctx.Log(), inputPath, outputDirPath)
+			})
+			if err != nil {
+				return fmt.Errorf("extract embedded files: %w", err)
+			}
+
+			if len(outputPaths) == 0 {
+				return api.WrapError(
+					errors.New("no embedded files"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "The PDF(s) do not contain embedded files"),
+				)
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// extractImagesRoute returns an [api.Route] which can extract the images of
+// PDFs.
+func extractImagesRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/images/extract",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var (
+				inputPaths []string
+				pageRanges string
+			)
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				String("pageRanges", &pageRanges, "").
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			outputPaths, err := extractFiles(ctx, inputPaths, func(inputPath, outputDirPath string) ([]string, error) {
+				return engine.ExtractImages(ctx, ctx.Log(), pageRanges, inputPath, outputDirPath)
+			})
+			if err != nil {
+				return fmt.Errorf("extract images: %w", err)
+			}
+
+			if len(outputPaths) == 0 {
+				return api.WrapError(
+					errors.New("no images"),
+					api.NewSentinelHttpError(http.StatusBadRequest, "The PDF(s) do not contain images"),
+				)
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// extractFiles runs an extraction for each PDF within its own subdirectory.
+// When there are many PDFs, the extracted files are prefixed with the
+// filename of their PDF, so that they do not collide in the archive.
+func extractFiles(ctx *api.Context, inputPaths []string, extract func(inputPath, outputDirPath string) ([]string, error)) ([]string, error) {
+	var outputPaths []string
+	for _, inputPath := range inputPaths {
+		filenameNoExt := strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
+		outputDirPath, err := ctx.CreateSubDirectory(strings.ReplaceAll(filenameNoExt, ".", "_"))
+		if err != nil {
+			return nil, fmt.Errorf("create subdirectory from input path: %w", err)
+		}
+
+		paths, err := extract(inputPath, outputDirPath)
+		if err != nil {
+			return nil, fmt.Errorf("extract files from '%s': %w", inputPath, err)
+		}
+
+		if len(inputPaths) == 1 {
+			outputPaths = append(outputPaths, paths...)
+			continue
+		}
+
+		for _, path := range paths {
+			newPath := fmt.Sprintf("%s/%s_%s", outputDirPath, filenameNoExt, filepath.Base(path))
+			err = ctx.Rename(path, newPath)
+			if err != nil {
+				return nil, fmt.Errorf("rename path: %w", err)
+			}
+
+			outputPaths = append(outputPaths, newPath)
+		}
+	}
+
+	return outputPaths, nil
+}
+
+// sanitizeRoute returns an [api.Route] which can remove active or hidden
+// content from PDFs.
+func sanitizeRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/sanitize",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			options := FormDataPdfSanitize(form, true)
+
+			var inputPaths []string
+			err := form.
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			err = SanitizeStub(ctx, engine, options, inputPaths)
+			if err != nil {
+				return fmt.Errorf("sanitize PDFs: %w", err)
+			}
+
+			err = ctx.AddOutputPaths(inputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// convertImagesRoute returns an [api.Route] which can convert images to PDF.
+func convertImagesRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/images/convert",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			form := ctx.FormData()
+			options := FormDataPdfImportImages(form)
+			pdfFormats := FormDataPdfFormats(form)
+			failOnNonCompliance := FormDataPdfFailOnNonCompliance(form)
+			metadata := FormDataPdfMetadata(form, false)
+			encryptOptions := FormDataPdfEncrypt(form)
+			signOptions := FormDataPdfSign(form, false)
+
+			var (
+				inputPaths []string
+				merge      bool
+			)
+			err := form.
+				MandatoryPaths([]string{".jpg", ".jpeg", ".png", ".tif", ".tiff"}, &inputPaths).
+				Bool("merge", &merge, false).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			var outputPaths []string
+			if merge {
+				outputPath := ctx.GeneratePath(".pdf")
+				err = engine.ImportImages(ctx, ctx.Log(), options, inputPaths, outputPath)
+				if err != nil {
+					return fmt.Errorf("import images: %w", err)
+				}
+
+				outputPaths = []string{outputPath}
+			} else {
+				outputPaths = make([]string, len(inputPaths))
+				for i, inputPath := range inputPaths {
+					outputPaths[i] = ctx.GeneratePath(".pdf")
+					err = engine.ImportImages(ctx, ctx.Log(), options, []string{inputPath}, outputPaths[i])
+					if err != nil {
+						return fmt.Errorf("import image '%s': %w", inputPath, err)
+					}
+				}
+			}
+
+			outputPaths, err = ConvertStub(ctx, engine, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("convert PDFs: %w", err)
+			}
+
+			err = WriteMetadataStub(ctx, engine, metadata, outputPaths)
+			if err != nil {
+				return fmt.Errorf("write metadata: %w", err)
+			}
+
+			err = ValidateComplianceStub(ctx, engine, failOnNonCompliance, pdfFormats, outputPaths)
+			if err != nil {
+				return fmt.Errorf("validate PDFs compliance: %w", err)
+			}
+
+			err = EncryptPdfStub(ctx, engine, encryptOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("encrypt PDFs: %w", err)
+			}
+
+			err = SignStub(ctx, engine, signOptions, outputPaths)
+			if err != nil {
+				return fmt.Errorf("sign PDFs: %w", err)
+			}
+
+			if len(outputPaths) > 1 {
+				// If .zip archive, image.png -> image.png.pdf.
+				for i, inputPath := range inputPaths {
+					outputPath := fmt.Sprintf("%s.pdf", inputPath)
+
+					err = ctx.Rename(outputPaths[i], outputPath)
+					if err != nil {
+						return fmt.Errorf("rename output path: %w", err)
+					}
+
+					outputPaths[i] = outputPath
+				}
+			}
+
+			err = ctx.AddOutputPaths(outputPaths...)
+			if err != nil {
+				return fmt.Errorf("add output paths: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// FormDataPdfRasterize creates a [gotenberg.RasterizeOptions] from the form
+// data.
+func FormDataPdfRasterize(form *api.FormData) gotenberg.RasterizeOptions {
+	options := gotenberg.RasterizeOptions{
+		Format: gotenberg.RasterizeFormatPng,
+	}
+
+	form.
+		Custom("format", func(value string) error {
+			switch value {
+			case "":
+			case gotenberg.RasterizeFormatPng, gotenberg.RasterizeFormatJpeg, gotenberg.RasterizeFormatWebp:
+				options.Format = value
+			default:
+				return fmt.Errorf("wrong value, expected either '%s', '%s' or '%s'", gotenberg.RasterizeFormatPng, gotenberg.RasterizeFormatJpeg, gotenberg.RasterizeFormatWebp)
+			}
+			return nil
+		}).
+		Custom("dpi", func(value string) error {
+			if value == "" {
+				return nil
+			}
+
+			dpi, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
 
-// EncryptPdfStub adds password protection to PDF files.
-func EncryptPdfStub(ctx *api.Context, engine gotenberg.PdfEngine, userPassword, ownerPassword string, inputPaths []string) error {
-	if userPassword == "" {
-		return nil
-	}
+			if dpi < 1 {
+				return errors.New("value must be greater than 0")
+			}
 
-	for _, inputPath := range inputPaths {
-		err := engine.Encrypt(ctx, ctx.Log(), inputPath, userPassword, ownerPassword)
-		if err != nil {
-			return fmt.Errorf("encrypt PDF '%s': %w", inputPath, err)
-		}
-	}
+			options.Dpi = dpi
+			return nil
+		}).
+		Custom("quality", func(value string) error {
+			if value == "" {
+				return nil
+			}
 
-	return nil
-}
+			quality, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
 
-// EmbedFilesStub embeds files into PDF files.
-func EmbedFilesStub(ctx *api.Context, engine gotenberg.PdfEngine, embedPaths []string, inputPaths []string) error {
-	if len(embedPaths) == 0 {
-		return nil
-	}
+			if quality < 1 || quality > 100 {
+				return errors.New("value must be between 1 and 100")
+			}
 
-	for _, inputPath := range inputPaths {
-		err := engine.EmbedFiles(ctx, ctx.Log(), embedPaths, inputPath)
-		if err != nil {
-			return fmt.Errorf("embed files into PDF '%s': %w", inputPath, err)
-		}
-	}
+			options.Quality = quality
+			return nil
+		}).
+		String("pageRanges", &options.PageRanges, "")
 
-	return nil
+	return options
 }
 
-// mergeRoute returns an [api.Route] which can merge PDFs.
-func mergeRoute(engine gotenberg.PdfEngine) api.Route {
+// rasterizeRoute returns an [api.Route] which can render the pages of PDFs as
+// images.
+func rasterizeRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/merge",
+		Path:        "/forms/pdfengines/rasterize",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
-			pdfFormats := FormDataPdfFormats(form)
-			metadata := FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := FormDataPdfEncrypt(form)
-			embedPaths := FormDataPdfEmbeds(form)
+			options := FormDataPdfRasterize(form)
 
 			var inputPaths []string
-			var flatten bool
 			err := form.
 				MandatoryPaths([]string{".pdf"}, &inputPaths).
-				Bool("flatten", &flatten, false).
 				Validate()
 			if err != nil {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			outputPath := ctx.GeneratePath(".pdf")
-			err = engine.Merge(ctx, ctx.Log(), inputPaths, outputPath)
+			outputPaths, err := extractFiles(ctx, inputPaths, func(inputPath, outputDirPath string) ([]string, error) {
+				return engine.Rasterize(ctx, ctx.Log(), options, inputPath, outputDirPath)
+			})
 			if err != nil {
-				return fmt.Errorf("merge PDFs: %w", err)
+				return fmt.Errorf("rasterize PDFs: %w", err)
 			}
 
-			outputPaths, err := ConvertStub(ctx, engine, pdfFormats, []string{outputPath})
+			err = ctx.AddOutputPaths(outputPaths...)
 			if err != nil {
-				return fmt.Errorf("convert PDF: %w", err)
+				return fmt.Errorf("add output paths: %w", err)
 			}
 
-			err = EmbedFilesStub(ctx, engine, embedPaths, outputPaths)
-			if err != nil {
-				return fmt.Errorf("embed files into PDFs: %w", err)
-			}
+			return nil
+		},
+	}
+}
 
-			err = WriteMetadataStub(ctx, engine, metadata, outputPaths)
-			if err != nil {
-				return fmt.Errorf("write metadata: %w", err)
-			}
+// FormDataPdfImpose creates a [gotenberg.ImposeOptions] from the form data.
+func FormDataPdfImpose(form *api.FormData) gotenberg.ImposeOptions {
+	options := gotenberg.ImposeOptions{
+		Mode: gotenberg.ImposeModeNup,
+	}
 
-			if flatten {
-				err = FlattenStub(ctx, engine, outputPaths)
-				if err != nil {
-					return fmt.Errorf("flatten PDFs: %w", err)
-				}
+	form.
+		Custom("mode", func(value string) error {
+			switch value {
+			case "":
+			case gotenberg.ImposeModeNup, gotenberg.ImposeModeBooklet:
+				options.Mode = value
+			default:
+				return fmt.Errorf("wrong value, expected either '%s' or '%s'", gotenberg.ImposeModeNup, gotenberg.ImposeModeBooklet)
+			}
+			return nil
+		}).
+		MandatoryInt("pagesPerSheet", &options.PagesPerSheet).
+		Custom("pageSize", func(value string) error {
+			if value != "" && !pageSizeRegexp.MatchString(value) {
+				return errors.New("wrong value, expected an ISO A, B or C size (e.g., 'A4'), 'Letter', 'Legal', 'Ledger' or 'Tabloid'")
+			}
+			options.PageSize = value
+			return nil
+		}).
+		Bool("landscape", &options.Landscape, false).
+		Custom("orientation", func(value string) error {
+			switch value {
+			case "", "right-down", "down-right", "left-down", "down-left":
+				options.Orientation = value
+				return nil
+			default:
+				return errors.New("wrong value, expected either 'right-down', 'down-right', 'left-down' or 'down-left'")
+			}
+		}).
+		Bool("border", &options.Border, false).
+		Custom("margin", func(value string) error {
+			if value == "" {
+				return nil
 			}
 
-			err = EncryptPdfStub(ctx, engine, userPassword, ownerPassword, outputPaths)
+			margin, err := strconv.ParseFloat(value, 64)
 			if err != nil {
-				return fmt.Errorf("encrypt PDFs: %w", err)
+				return err
 			}
 
-			err = ctx.AddOutputPaths(outputPaths...)
-			if err != nil {
-				return fmt.Errorf("add output paths: %w", err)
+			if margin < 0 {
+				return errors.New("value is inferior to 0")
 			}
 
+			options.Margin = margin
 			return nil
-		},
-	}
+		})
+
+	return options
 }
 
-// splitRoute returns an [api.Route] which can extract pages from a PDF.
-func splitRoute(engine gotenberg.PdfEngine) api.Route {
+// imposeRoute returns an [api.Route] which can arrange the pages of PDFs on
+// sheets, either in a grid or as a booklet.
+func imposeRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/split",
+		Path:        "/forms/pdfengines/impose",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
-			mode := FormDataPdfSplitMode(form, true)
-			pdfFormats := FormDataPdfFormats(form)
-			metadata := FormDataPdfMetadata(form, false)
-			userPassword, ownerPassword := FormDataPdfEncrypt(form)
-			embedPaths := FormDataPdfEmbeds(form)
+			options := FormDataPdfImpose(form)
 
 			var inputPaths []string
-			var flatten bool
 			err := form.
 				MandatoryPaths([]string{".pdf"}, &inputPaths).
-				Bool("flatten", &flatten, false).
 				Validate()
 			if err != nil {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			outputPaths, err := SplitPdfStub(ctx, engine, mode, inputPaths)
-			if err != nil {
-				return fmt.Errorf("split PDFs: %w", err)
-			}
-
-			convertOutputPaths, err := ConvertStub(ctx, engine, pdfFormats, outputPaths)
-			if err != nil {
-				return fmt.Errorf("convert PDFs: %w", err)
-			}
-
-			err = EmbedFilesStub(ctx, engine, embedPaths, convertOutputPaths)
-			if err != nil {
-				return fmt.Errorf("embed files into PDFs: %w", err)
-			}
-
-			err = WriteMetadataStub(ctx, engine, metadata, convertOutputPaths)
-			if err != nil {
-				return fmt.Errorf("write metadata: %w", err)
-			}
+			outputPaths := make([]string, len(inputPaths))
+			for i, inputPath := range inputPaths {
+				outputPaths[i] = ctx.GeneratePath(".pdf")
 
-			if flatten {
-				err = FlattenStub(ctx, engine, convertOutputPaths)
+				err = engine.Impose(ctx, ctx.Log(), options, inputPath, outputPaths[i])
 				if err != nil {
-					return fmt.Errorf("flatten PDFs: %w", err)
+					return fmt.Errorf("impose '%s': %w", inputPath, err)
 				}
 			}
 
-			err = EncryptPdfStub(ctx, engine, userPassword, ownerPassword, convertOutputPaths)
-			if err != nil {
-				return fmt.Errorf("encrypt PDFs: %w", err)
-			}
-
-			zeroValuedSplitMode := gotenberg.SplitMode{}
-			zeroValuedPdfFormats := gotenberg.PdfFormats{}
-			if mode != zeroValuedSplitMode && pdfFormats != zeroValuedPdfFormats {
-				// Rename the files to keep the split naming.
-				for i, convertOutputPath := range convertOutputPaths {
-					err = ctx.Rename(convertOutputPath, outputPaths[i])
+			if len(outputPaths) > 1 {
+				// If .zip archive, keep the original filename.
+				for i, inputPath := range inputPaths {
+					err = ctx.Rename(outputPaths[i], inputPath)
 					if err != nil {
 						return fmt.Errorf("rename output path: %w", err)
 					}
+					outputPaths[i] = inputPath
 				}
 			}
 
@@ -449,16 +3077,60 @@ func splitRoute(engine gotenberg.PdfEngine) api.Route {
 	}
 }
 
-// flattenRoute returns an [api.Route] which can flatten PDFs.
-func flattenRoute(engine gotenberg.PdfEngine) api.Route {
+// FormDataPdfPageEdit creates a list of [gotenberg.PageEditInstruction] from
+// the "instructions" form field, a JSON array of instructions applied in
+// order.
+func FormDataPdfPageEdit(form *api.FormData) []gotenberg.PageEditInstruction {
+	var instructions []gotenberg.PageEditInstruction
+
+	form.MandatoryCustom("instructions", func(value string) error {
+		err := json.Unmarshal([]byte(value), &instructions)
+		if err != nil {
+			return fmt.Errorf("unmarshal instructions: %w", err)
+		}
+
+		if len(instructions) == 0 {
+			return errors.New("at least one instruction is required")
+		}
+
+		for i, instruction := range instructions {
+			switch instruction.Op {
+			case gotenberg.PageEditKeep, gotenberg.PageEditDelete, gotenberg.PageEditMove, gotenberg.PageEditDuplicate:
+				if strings.TrimSpace(instruction.Pages) == "" {
+					return fmt.Errorf("instruction %d: pages are required for the '%s' operation", i+1, instruction.Op)
+				}
+			case gotenberg.PageEditInsertBlank:
+			default:
+				return fmt.Errorf("instruction %d: wrong operation '%s', expected either '%s', '%s', '%s', '%s' or '%s'", i+1, instruction.Op, gotenberg.PageEditKeep, gotenberg.PageEditDelete, gotenberg.PageEditInsertBlank, gotenberg.PageEditMove, gotenberg.PageEditDuplicate)
+			}
+
+			if instruction.After < 0 {
+				return fmt.Errorf("instruction %d: after must be greater than or equal to 0", i+1)
+			}
+
+			if instruction.Count < 0 {
+				return fmt.Errorf("instruction %d: count must be greater than or equal to 0", i+1)
+			}
+		}
+
+		return nil
+	})
+
+	return instructions
+}
+
+// pageEditRoute returns an [api.Route] which can delete, insert, move and
+// duplicate the pages of PDFs.
+func pageEditRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/flatten",
+		Path:        "/forms/pdfengines/pages",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
+			instructions := FormDataPdfPageEdit(form)
 
 			var inputPaths []string
 			err := form.
@@ -468,9 +3140,11 @@ func flattenRoute(engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			err = FlattenStub(ctx, engine, inputPaths)
-			if err != nil {
-				return fmt.Errorf("flatten PDFs: %w", err)
+			for _, inputPath := range inputPaths {
+				err = engine.PageEdit(ctx, ctx.Log(), instructions, inputPath)
+				if err != nil {
+					return fmt.Errorf("edit pages of '%s': %w", inputPath, err)
+				}
 			}
 
 			err = ctx.AddOutputPaths(inputPaths...)
@@ -483,18 +3157,17 @@ func flattenRoute(engine gotenberg.PdfEngine) api.Route {
 	}
 }
 
-// convertRoute returns an [api.Route] which can convert PDFs to a specific ODF
-// format.
-func convertRoute(engine gotenberg.PdfEngine) api.Route {
+// signRoute returns an [api.Route] which can digitally sign PDFs.
+func signRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/convert",
+		Path:        "/forms/pdfengines/sign",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
-			pdfFormats := FormDataPdfFormats(form)
+			options := FormDataPdfSign(form, true)
 
 			var inputPaths []string
 			err := form.
@@ -504,68 +3177,93 @@ func convertRoute(engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			zeroValued := gotenberg.PdfFormats{}
-			if pdfFormats == zeroValued {
-				return api.WrapError(
-					errors.New("no PDF formats"),
-					api.NewSentinelHttpError(
-						http.StatusBadRequest,
-						"Invalid form data: either 'pdfa' or 'pdfua' form fields must be provided",
-					),
-				)
+			err = SignStub(ctx, engine, options, inputPaths)
+			if err != nil {
+				return fmt.Errorf("sign PDFs: %w", err)
 			}
 
-			outputPaths, err := ConvertStub(ctx, engine, pdfFormats, inputPaths)
+			err = ctx.AddOutputPaths(inputPaths...)
 			if err != nil {
-				return fmt.Errorf("convert PDFs: %w", err)
+				return fmt.Errorf("add output paths: %w", err)
 			}
 
-			if len(outputPaths) > 1 {
-				// If .zip archive, keep the original filename.
-				for i, inputPath := range inputPaths {
-					err = ctx.Rename(outputPaths[i], inputPath)
-					if err != nil {
-						return fmt.Errorf("rename output path: %w", err)
-					}
-					outputPaths[i] = inputPath
+			return nil
+		},
+	}
+}
+
+// verifySignaturesRoute returns an [api.Route] which verifies the digital
+// signatures of PDFs.
+func verifySignaturesRoute(engine gotenberg.PdfEngine) api.Route {
+	return api.Route{
+		Method:      http.MethodPost,
+		Path:        "/forms/pdfengines/signatures/verify",
+		IsMultipart: true,
+		Handler: func(c echo.Context) error {
+			ctx := c.Get("context").(*api.Context)
+
+			var inputPaths []string
+			err := ctx.FormData().
+				MandatoryPaths([]string{".pdf"}, &inputPaths).
+				Validate()
+			if err != nil {
+				return fmt.Errorf("validate form data: %w", err)
+			}
+
+			res := make(map[string][]gotenberg.PdfSignature, len(inputPaths))
+			for _, inputPath := range inputPaths {
+				signatures, err := engine.VerifySignatures(ctx, ctx.Log(), inputPath)
+				if err != nil {
+					return fmt.Errorf("verify signatures: %w", err)
 				}
+
+				res[filepath.Base(inputPath)] = signatures
 			}
 
-			err = ctx.AddOutputPaths(outputPaths...)
+			err = c.JSON(http.StatusOK, res)
 			if err != nil {
-				return fmt.Errorf("add output paths: %w", err)
+				if strings.Contains(err.Error(), "request method or response status code does not allow body") {
+					// High probability that the user is using the webhook
+					// feature. It does not make sense for this route.
+					return api.ErrNoOutputFile
+				}
+				return fmt.Errorf("return JSON response: %w", err)
 			}
 
-			return nil
+			return api.ErrNoOutputFile
 		},
 	}
 }
 
-// readMetadataRoute returns an [api.Route] which returns the metadata of PDFs.
-func readMetadataRoute(engine gotenberg.PdfEngine) api.Route {
+// validateRoute returns an [api.Route] which validates PDFs against
+// conformance profiles.
+func validateRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/metadata/read",
+		Path:        "/forms/pdfengines/validate",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
+			form := ctx.FormData()
+			pdfFormats := FormDataPdfFormats(form)
+
 			var inputPaths []string
-			err := ctx.FormData().
+			err := form.
 				MandatoryPaths([]string{".pdf"}, &inputPaths).
 				Validate()
 			if err != nil {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			res := make(map[string]map[string]interface{}, len(inputPaths))
+			res := make(map[string][]gotenberg.PdfValidationReport, len(inputPaths))
 			for _, inputPath := range inputPaths {
-				metadata, err := engine.ReadMetadata(ctx, ctx.Log(), inputPath)
+				reports, err := engine.ValidateCompliance(ctx, ctx.Log(), pdfFormats, inputPath)
 				if err != nil {
-					return fmt.Errorf("read metadata: %w", err)
+					return fmt.Errorf("validate compliance: %w", err)
 				}
 
-				res[filepath.Base(inputPath)] = metadata
+				res[filepath.Base(inputPath)] = reports
 			}
 
 			err = c.JSON(http.StatusOK, res)
@@ -583,18 +3281,16 @@ func readMetadataRoute(engine gotenberg.PdfEngine) api.Route {
 	}
 }
 
-// writeMetadataRoute returns an [api.Route] which can write metadata into
-// PDFs.
-func writeMetadataRoute(engine gotenberg.PdfEngine) api.Route {
+// repairRoute returns an [api.Route] which repairs damaged PDFs.
+func repairRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/metadata/write",
+		Path:        "/forms/pdfengines/repair",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
-			metadata := FormDataPdfMetadata(form, true)
 
 			var inputPaths []string
 			err := form.
@@ -604,9 +3300,9 @@ func writeMetadataRoute(engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			err = WriteMetadataStub(ctx, engine, metadata, inputPaths)
+			err = RepairStub(ctx, engine, inputPaths)
 			if err != nil {
-				return fmt.Errorf("write metadata: %w", err)
+				return fmt.Errorf("repair PDFs: %w", err)
 			}
 
 			err = ctx.AddOutputPaths(inputPaths...)
@@ -619,32 +3315,40 @@ func writeMetadataRoute(engine gotenberg.PdfEngine) api.Route {
 	}
 }
 
-// encryptRoute returns an [api.Route] which can add password protection to PDFs.
-func encryptRoute(engine gotenberg.PdfEngine) api.Route {
+// resizeRoute returns an [api.Route] which can resize the pages of PDFs.
+func resizeRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/encrypt",
+		Path:        "/forms/pdfengines/resize",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
+			options := FormDataPdfResize(form)
 
 			var inputPaths []string
-			var userPassword string
-			var ownerPassword string
 			err := form.
 				MandatoryPaths([]string{".pdf"}, &inputPaths).
-				MandatoryString("userPassword", &userPassword).
-				String("ownerPassword", &ownerPassword, "").
 				Validate()
 			if err != nil {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			err = EncryptPdfStub(ctx, engine, userPassword, ownerPassword, inputPaths)
+			zeroValued := gotenberg.ResizeOptions{}
+			if options == zeroValued {
+				return api.WrapError(
+					errors.New("no resize options"),
+					api.NewSentinelHttpError(
+						http.StatusBadRequest,
+						"Invalid form data: either 'paperWidth' and 'paperHeight' or 'scale' form fields must be provided",
+					),
+				)
+			}
+
+			err = ResizeStub(ctx, engine, options, inputPaths)
 			if err != nil {
-				return fmt.Errorf("encrypt PDFs: %w", err)
+				return fmt.Errorf("resize PDFs: %w", err)
 			}
 
 			err = ctx.AddOutputPaths(inputPaths...)
@@ -657,17 +3361,17 @@ func encryptRoute(engine gotenberg.PdfEngine) api.Route {
 	}
 }
 
-// embedRoute returns an [api.Route] which can add embedded files to PDFs.
-func embedRoute(engine gotenberg.PdfEngine) api.Route {
+// cropRoute returns an [api.Route] which can set the page boxes of PDFs.
+func cropRoute(engine gotenberg.PdfEngine) api.Route {
 	return api.Route{
 		Method:      http.MethodPost,
-		Path:        "/forms/pdfengines/embed",
+		Path:        "/forms/pdfengines/crop",
 		IsMultipart: true,
 		Handler: func(c echo.Context) error {
 			ctx := c.Get("context").(*api.Context)
 
 			form := ctx.FormData()
-			embedPaths := FormDataPdfEmbeds(form)
+			options := FormDataPdfCrop(form)
 
 			var inputPaths []string
 			err := form.
@@ -677,9 +3381,19 @@ func embedRoute(engine gotenberg.PdfEngine) api.Route {
 				return fmt.Errorf("validate form data: %w", err)
 			}
 
-			err = EmbedFilesStub(ctx, engine, embedPaths, inputPaths)
+			if options.CropBox == nil && options.TrimBox == nil && options.BleedBox == nil {
+				return api.WrapError(
+					errors.New("no page boxes"),
+					api.NewSentinelHttpError(
+						http.StatusBadRequest,
+						"Invalid form data: either 'cropBox', 'trimBox' or 'bleedBox' form fields must be provided",
+					),
+				)
+			}
+
+			err = CropStub(ctx, engine, options, inputPaths)
 			if err != nil {
-				return fmt.Errorf("embed files into PDFs: %w", err)
+				return fmt.Errorf("crop PDFs: %w", err)
 			}
 
 			err = ctx.AddOutputPaths(inputPaths...)
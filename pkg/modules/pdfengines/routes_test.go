@@ -0,0 +1,305 @@
+package pdfengines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+	"github.com/gotenberg/gotenberg/v8/pkg/modules/api"
+)
+
+func TestRetryWithRepairStub(t *testing.T) {
+	for _, tc := range []struct {
+		scenario            string
+		autoRepair          bool
+		inputPaths          []string
+		damagedPaths        []string
+		damageErr           error
+		repairErr           error
+		expectRepairedPaths []string
+		expectRepairs       int
+		expectError         bool
+	}{
+		{
+			scenario:   "no damaged PDF",
+			autoRepair: true,
+			inputPaths: []string{"/foo/page_1.pdf", "/foo/page_2.pdf"},
+		},
+		{
+			scenario:     "auto repair disabled",
+			autoRepair:   false,
+			inputPaths:   []string{"/foo/damaged.pdf"},
+			damagedPaths: []string{"/foo/damaged.pdf"},
+			damageErr:    errors.New("foo"),
+			expectError:  true,
+		},
+		{
+			scenario:            "only one damaged PDF among many",
+			autoRepair:          true,
+			inputPaths:          []string{"/foo/damaged.pdf", "/foo/page_1.pdf", "/foo/page_2.pdf"},
+			damagedPaths:        []string{"/foo/damaged.pdf"},
+			damageErr:           errors.New("foo"),
+			expectRepairedPaths: []string{"/foo/damaged.pdf"},
+			expectRepairs:       1,
+		},
+		{
+			scenario:            "many damaged PDFs",
+			autoRepair:          true,
+			inputPaths:          []string{"/foo/damaged_1.pdf", "/foo/damaged_2.pdf", "/foo/page_1.pdf"},
+			damagedPaths:        []string{"/foo/damaged_1.pdf", "/foo/damaged_2.pdf"},
+			damageErr:           errors.New("foo"),
+			expectRepairedPaths: []string{"/foo/damaged_1.pdf", "/foo/damaged_2.pdf"},
+			expectRepairs:       2,
+		},
+		{
+			scenario:     "invalid arguments",
+			autoRepair:   true,
+			inputPaths:   []string{"/foo/damaged.pdf"},
+			damagedPaths: []string{"/foo/damaged.pdf"},
+			damageErr:    gotenberg.NewPdfEngineInvalidArgs("foo", "bar"),
+			expectError:  true,
+		},
+		{
+			scenario:     "invalid password",
+			autoRepair:   true,
+			inputPaths:   []string{"/foo/damaged.pdf"},
+			damagedPaths: []string{"/foo/damaged.pdf"},
+			damageErr:    fmt.Errorf("foo: %w", gotenberg.ErrPdfInvalidPassword),
+			expectError:  true,
+		},
+		{
+			scenario:     "method not supported",
+			autoRepair:   true,
+			inputPaths:   []string{"/foo/damaged.pdf"},
+			damagedPaths: []string{"/foo/damaged.pdf"},
+			damageErr:    fmt.Errorf("foo: %w", gotenberg.ErrPdfEngineMethodNotSupported),
+			expectError:  true,
+		},
+		{
+			scenario:     "repair failure",
+			autoRepair:   true,
+			inputPaths:   []string{"/foo/damaged.pdf"},
+			damagedPaths: []string{"/foo/damaged.pdf"},
+			damageErr:    errors.New("foo"),
+			repairErr:    errors.New("bar"),
+			expectError:  true,
+		},
+		{
+			scenario:      "still failing after repair",
+			autoRepair:    true,
+			inputPaths:    []string{"/foo/page_1.pdf", "/foo/page_2.pdf"},
+			damagedPaths:  []string{"/foo/unrelated.pdf"},
+			damageErr:     errors.New("foo"),
+			expectRepairs: 2,
+			expectError:   true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ctx := &api.ContextMock{Context: &api.Context{Context: context.Background()}}
+			ctx.SetLogger(zap.NewNop())
+
+			repaired := make(map[string]bool)
+			engine := &gotenberg.PdfEngineMock{
+				RepairMock: func(ctx context.Context, logger *zap.Logger, inputPath string) error {
+					if tc.repairErr != nil {
+						return tc.repairErr
+					}
+					repaired[inputPath] = true
+
+					return nil
+				},
+			}
+
+			operation := func() error {
+				for _, damagedPath := range tc.damagedPaths {
+					if !repaired[damagedPath] {
+						return tc.damageErr
+					}
+				}
+
+				return nil
+			}
+
+			repairedPaths, err := RetryWithRepairStub(ctx.Context, engine, tc.autoRepair, tc.inputPaths, operation)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(repairedPaths, tc.expectRepairedPaths) {
+				t.Errorf("expected repaired paths %+v but got: %+v", tc.expectRepairedPaths, repairedPaths)
+			}
+
+			if len(repaired) != tc.expectRepairs {
+				t.Errorf("expected %d repaired PDF(s) but got %d", tc.expectRepairs, len(repaired))
+			}
+		})
+	}
+}
+
+func TestFormDataPdfSign(t *testing.T) {
+	for _, tc := range []struct {
+		scenario      string
+		values        map[string][]string
+		mandatory     bool
+		expectOptions bool
+		expectError   bool
+	}{
+		{
+			scenario:      "no sign form field",
+			values:        map[string][]string{"userPassword": {"foo"}},
+			expectOptions: false,
+		},
+		{
+			scenario:      "sign",
+			values:        map[string][]string{"sign": {"true"}},
+			expectOptions: true,
+		},
+		{
+			scenario:      "sign and encrypt",
+			values:        map[string][]string{"sign": {"true"}, "userPassword": {"foo"}},
+			expectOptions: true,
+			expectError:   true,
+		},
+		{
+			scenario:      "owner password only",
+			values:        map[string][]string{"sign": {"true"}, "ownerPassword": {"foo"}},
+			expectOptions: true,
+		},
+		{
+			scenario:      "mandatory",
+			values:        map[string][]string{"userPassword": {"foo"}},
+			mandatory:     true,
+			expectOptions: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ctx := &api.ContextMock{Context: &api.Context{}}
+			ctx.SetValues(tc.values)
+
+			form := ctx.FormData()
+			options := FormDataPdfSign(form, tc.mandatory)
+			err := form.Validate()
+
+			if tc.expectOptions && options == nil {
+				t.Fatal("expected options but got none")
+			}
+
+			if !tc.expectOptions && options != nil {
+				t.Fatalf("expected no options but got: %+v", options)
+			}
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestFormDataPdfOptimize(t *testing.T) {
+	for _, tc := range []struct {
+		scenario      string
+		values        map[string][]string
+		mandatory     bool
+		expectOptions gotenberg.OptimizeOptions
+		expectError   bool
+	}{
+		{
+			scenario:      "nothing to optimize",
+			values:        map[string][]string{"sign": {"foo"}},
+			expectOptions: gotenberg.OptimizeOptions{},
+		},
+		{
+			scenario:      "compress and linearize",
+			values:        map[string][]string{"compress": {"true"}, "linearize": {"true"}},
+			expectOptions: gotenberg.OptimizeOptions{CompressStreams: true, ObjectStreams: true, Linearize: true},
+		},
+		{
+			scenario:      "linearize and encrypt",
+			values:        map[string][]string{"linearize": {"true"}, "userPassword": {"foo"}},
+			expectOptions: gotenberg.OptimizeOptions{Linearize: true},
+			expectError:   true,
+		},
+		{
+			scenario:      "linearize and sign",
+			values:        map[string][]string{"linearize": {"true"}, "sign": {"true"}},
+			expectOptions: gotenberg.OptimizeOptions{Linearize: true},
+			expectError:   true,
+		},
+		{
+			scenario:      "mandatory",
+			values:        map[string][]string{"linearize": {"true"}, "userPassword": {"foo"}},
+			mandatory:     true,
+			expectOptions: gotenberg.OptimizeOptions{CompressStreams: true, ObjectStreams: true, Linearize: true},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ctx := &api.ContextMock{Context: &api.Context{}}
+			ctx.SetValues(tc.values)
+
+			form := ctx.FormData()
+			options := FormDataPdfOptimize(form, tc.mandatory)
+			err := form.Validate()
+
+			if options != tc.expectOptions {
+				t.Errorf("expected options %+v but got: %+v", tc.expectOptions, options)
+			}
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+		})
+	}
+}
+
+func TestOptimizeStub(t *testing.T) {
+	ctx := &api.ContextMock{Context: &api.Context{Context: context.Background()}}
+	ctx.SetLogger(zap.NewNop())
+
+	var calls []gotenberg.OptimizeOptions
+	engine := &gotenberg.PdfEngineMock{
+		OptimizeMock: func(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+			calls = append(calls, options)
+			return nil
+		},
+	}
+
+	options := gotenberg.OptimizeOptions{CompressStreams: true, RemoveDuplicates: true, CompressImages: true, Linearize: true}
+
+	err := OptimizeStub(ctx.Context, engine, options, []string{"/foo/foo.pdf"})
+	if err != nil {
+		t.Fatalf("expected no error from OptimizeStub but got: %v", err)
+	}
+
+	err = LinearizeStub(ctx.Context, engine, options, []string{"/foo/foo.pdf"})
+	if err != nil {
+		t.Fatalf("expected no error from LinearizeStub but got: %v", err)
+	}
+
+	expectCalls := []gotenberg.OptimizeOptions{
+		{CompressStreams: true, RemoveDuplicates: true},
+		{CompressImages: true},
+		{Linearize: true},
+	}
+
+	if !reflect.DeepEqual(calls, expectCalls) {
+		t.Errorf("expected calls %+v but got: %+v", expectCalls, calls)
+	}
+}
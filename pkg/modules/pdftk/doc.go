@@ -3,6 +3,10 @@
 //
 // 1. The merging of PDF files.
 // 2. The splitting of PDF files.
+// 3. The rotation of PDF pages.
+// 4. The overlaying and underlaying of PDF pages.
+// 5. The decryption of PDF files.
+// 6. The filling of PDF forms.
 //
 // The path to the PDFtk binary must be specified using the PDFTK_BIN_PATH
 // environment variable.
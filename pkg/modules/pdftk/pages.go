@@ -0,0 +1,37 @@
+package pdftk
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// pageRanges translates a selection of pages (see
+// [gotenberg.ParsePageRanges]) to the PDFtk page ranges syntax, where "end"
+// is the last page, and appends the given qualifier (e.g., a rotation
+// direction) to each range. An empty selection means all pages.
+func pageRanges(selection, qualifier string) ([]string, error) {
+	if selection == "" {
+		return []string{"1-end" + qualifier}, nil
+	}
+
+	ranges, err := gotenberg.ParsePageRanges(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(ranges))
+	for i, pageRange := range ranges {
+		switch pageRange.Last {
+		case pageRange.First:
+			values[i] = strconv.Itoa(pageRange.First) + qualifier
+		case 0:
+			values[i] = fmt.Sprintf("%d-end%s", pageRange.First, qualifier)
+		default:
+			values[i] = fmt.Sprintf("%d-%d%s", pageRange.First, pageRange.Last, qualifier)
+		}
+	}
+
+	return values, nil
+}
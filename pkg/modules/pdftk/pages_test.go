@@ -0,0 +1,47 @@
+package pdftk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		selection    string
+		expectRanges []string
+		expectError  bool
+	}{
+		{
+			scenario:     "all pages",
+			selection:    "",
+			expectRanges: []string{"1-endright"},
+		},
+		{
+			scenario:     "ranges",
+			selection:    "1-3, 5, 8-",
+			expectRanges: []string{"1-3right", "5right", "8-endright"},
+		},
+		{
+			scenario:    "PDFtk syntax",
+			selection:   "2-end",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ranges, err := pageRanges(tc.selection, "right")
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(ranges, tc.expectRanges) {
+				t.Errorf("expected %v but got: %v", tc.expectRanges, ranges)
+			}
+		})
+	}
+}
@@ -145,25 +145,85 @@ func (engine *PdfTk) WriteMetadata(ctx context.Context, logger *zap.Logger, meta
 	return fmt.Errorf("write PDF metadata with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
-// Encrypt adds password protection to a PDF file using PDFtk.
-func (engine *PdfTk) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
-	if userPassword == "" {
+// Encrypt adds password protection to a PDF file using PDFtk. It defaults to
+// the RC4 128-bit encryption, and does not support the AES 256-bit
+// encryption.
+func (engine *PdfTk) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	if options.UserPassword == "" {
 		return errors.New("user password cannot be empty")
 	}
 
-	if ownerPassword == userPassword || ownerPassword == "" {
+	if options.OwnerPassword == options.UserPassword || options.OwnerPassword == "" {
 		return gotenberg.NewPdfEngineInvalidArgs("pdftk", "both 'userPassword' and 'ownerPassword' must be provided and different. Consider switching to another PDF engine if this behavior does not work with your workflow")
 	}
 
+	var strength string
+	switch options.Algorithm {
+	case "", gotenberg.EncryptionAlgorithmRc4128:
+		strength = "encrypt_128bit"
+	case gotenberg.EncryptionAlgorithmRc440:
+		strength = "encrypt_40bit"
+	case gotenberg.EncryptionAlgorithmAes128:
+		strength = "encrypt_aes128"
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("pdftk", fmt.Sprintf("encryption algorithm '%s' is not supported", options.Algorithm))
+	}
+
+	// Without permissions, PDFtk allows nothing, as it always did.
+	var allow []string
+	if options.Permissions != nil {
+		permissions := *options.Permissions
+		if permissions == (gotenberg.PdfPermissions{
+			Print:            true,
+			PrintHighQuality: true,
+			Modify:           true,
+			Copy:             true,
+			Annotate:         true,
+			FillForms:        true,
+			Assemble:         true,
+			Accessibility:    true,
+		}) {
+			allow = append(allow, "AllFeatures")
+		} else {
+			if permissions.Print && permissions.PrintHighQuality {
+				allow = append(allow, "Printing")
+			} else if permissions.Print {
+				allow = append(allow, "DegradedPrinting")
+			}
+			if permissions.Modify {
+				allow = append(allow, "ModifyContents")
+			}
+			if permissions.Copy {
+				allow = append(allow, "CopyContents")
+			}
+			if permissions.Annotate {
+				allow = append(allow, "ModifyAnnotations")
+			}
+			if permissions.FillForms {
+				allow = append(allow, "FillIn")
+			}
+			if permissions.Assemble {
+				allow = append(allow, "Assembly")
+			}
+			if permissions.Accessibility {
+				allow = append(allow, "ScreenReaders")
+			}
+		}
+	}
+
 	// Create a temp output file in the same directory.
 	tmpPath := inputPath + ".tmp"
 
 	var args []string
 	args = append(args, inputPath)
 	args = append(args, "output", tmpPath)
-	args = append(args, "encrypt_128bit")
-	args = append(args, "user_pw", userPassword)
-	args = append(args, "owner_pw", ownerPassword)
+	args = append(args, strength)
+	args = append(args, "user_pw", options.UserPassword)
+	args = append(args, "owner_pw", options.OwnerPassword)
+	if len(allow) > 0 {
+		args = append(args, "allow")
+		args = append(args, allow...)
+	}
 
 	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
 	if err != nil {
@@ -188,6 +248,270 @@ func (engine *PdfTk) EmbedFiles(ctx context.Context, logger *zap.Logger, filePat
 	return fmt.Errorf("embed files with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
+// Rotate rotates the pages of a PDF file clockwise by the given angle.
+func (engine *PdfTk) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	var direction string
+	switch (angle%360 + 360) % 360 {
+	case 90:
+		direction = "right"
+	case 180:
+		direction = "down"
+	case 270:
+		direction = "left"
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("pdftk", fmt.Sprintf("angle %d is not a multiple of 90", angle))
+	}
+
+	ranges, err := pageRanges(pages, direction)
+	if err != nil {
+		return gotenberg.NewPdfEngineInvalidArgs("pdftk", err.Error())
+	}
+
+	// Create a temp output file in the same directory.
+	tmpPath := inputPath + ".tmp"
+
+	var args []string
+	args = append(args, inputPath, "rotate")
+	args = append(args, ranges...)
+	args = append(args, "output", tmpPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("rotate PDF with PDFtk: %w", err)
+	}
+
+	err = os.Rename(tmpPath, inputPath)
+	if err != nil {
+		return fmt.Errorf("rename temporary output file with input file: %w", err)
+	}
+
+	return nil
+}
+
+// Watermark is not available in this implementation.
+func (engine *PdfTk) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay lays the pages of another PDF over or under the pages of a PDF
+// file. Only the "repeat" mode is available, as PDFtk lays the last page of
+// the overlay PDF on the remaining pages.
+func (engine *PdfTk) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	if overlay.Mode != gotenberg.OverlayModeRepeat {
+		return gotenberg.NewPdfEngineInvalidArgs("pdftk", fmt.Sprintf("overlay mode '%s' is not supported", overlay.Mode))
+	}
+
+	operation := "multistamp"
+	if overlay.Underlay {
+		operation = "multibackground"
+	}
+
+	// Create a temp output file in the same directory.
+	tmpPath := inputPath + ".tmp"
+
+	var args []string
+	args = append(args, inputPath, operation, overlay.Path)
+	args = append(args, "output", tmpPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("overlay PDF with PDFtk: %w", err)
+	}
+
+	err = os.Rename(tmpPath, inputPath)
+	if err != nil {
+		return fmt.Errorf("rename temporary output file with input file: %w", err)
+	}
+
+	return nil
+}
+
+// Info is not available in this implementation.
+func (engine *PdfTk) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *PdfTk) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt removes the password protection of a PDF file.
+func (engine *PdfTk) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	// Create a temp output file in the same directory.
+	tmpPath := inputPath + ".tmp"
+
+	var args []string
+	args = append(args, inputPath)
+	if password != "" {
+		args = append(args, "input_pw", password)
+	}
+	args = append(args, "output", tmpPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("decrypt PDF with PDFtk: %w", err)
+	}
+
+	err = os.Rename(tmpPath, inputPath)
+	if err != nil {
+		return fmt.Errorf("rename temporary output file with input file: %w", err)
+	}
+
+	return nil
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *PdfTk) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *PdfTk) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm fills the AcroForm fields of a PDF file.
+func (engine *PdfTk) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	data, err := marshalXfdf(values)
+	if err != nil {
+		return gotenberg.NewPdfEngineInvalidArgs("pdftk", err.Error())
+	}
+
+	xfdfPath := inputPath + ".xfdf"
+	err = os.WriteFile(xfdfPath, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("write XFDF file: %w", err)
+	}
+
+	defer func() {
+		err := os.Remove(xfdfPath)
+		if err != nil {
+			logger.Error(fmt.Sprintf("remove XFDF file: %s", err))
+		}
+	}()
+
+	// Create a temp output file in the same directory.
+	tmpPath := inputPath + ".tmp"
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, "fill_form", xfdfPath)
+	args = append(args, "output", tmpPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("fill PDF form with PDFtk: %w", err)
+	}
+
+	err = os.Rename(tmpPath, inputPath)
+	if err != nil {
+		return fmt.Errorf("rename temporary output file with input file: %w", err)
+	}
+
+	return nil
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *PdfTk) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *PdfTk) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *PdfTk) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *PdfTk) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *PdfTk) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *PdfTk) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *PdfTk) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *PdfTk) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *PdfTk) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *PdfTk) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *PdfTk) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *PdfTk) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *PdfTk) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *PdfTk) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *PdfTk) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *PdfTk) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with PDFtk: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
 // Interface guards.
 var (
 	_ gotenberg.Module      = (*PdfTk)(nil)
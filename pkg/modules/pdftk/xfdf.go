@@ -0,0 +1,98 @@
+package pdftk
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// xfdf is the root element of an XML Forms Data Format document.
+type xfdf struct {
+	XMLName xml.Name     `xml:"http://ns.adobe.com/xfdf/ xfdf"`
+	Fields  []*xfdfField `xml:"fields>field"`
+}
+
+// xfdfField is a form field. Fully qualified names (e.g., "parent.child")
+// are represented by nested fields.
+type xfdfField struct {
+	Name   string       `xml:"name,attr"`
+	Values []string     `xml:"value"`
+	Fields []*xfdfField `xml:"field"`
+}
+
+// marshalXfdf creates an XFDF document from values keyed by fully qualified
+// field names. Checkboxes set to true use the "Yes" export value, while false
+// ones use "Off".
+func marshalXfdf(values map[string]interface{}) ([]byte, error) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var doc xfdf
+
+	for _, name := range names {
+		fieldValues, err := xfdfValues(values[name])
+		if err != nil {
+			return nil, fmt.Errorf("field '%s': %w", name, err)
+		}
+
+		fields := &doc.Fields
+		var field *xfdfField
+
+		for _, part := range strings.Split(name, ".") {
+			field = nil
+			for _, candidate := range *fields {
+				if candidate.Name == part {
+					field = candidate
+					break
+				}
+			}
+
+			if field == nil {
+				field = &xfdfField{Name: part}
+				*fields = append(*fields, field)
+			}
+
+			fields = &field.Fields
+		}
+
+		field.Values = fieldValues
+	}
+
+	data, err := xml.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal XFDF: %w", err)
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+func xfdfValues(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}, nil
+	case bool:
+		if v {
+			return []string{"Yes"}, nil
+		}
+		return []string{"Off"}, nil
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of strings, got '%v'", value)
+			}
+			values[i] = str
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported value '%v'", value)
+	}
+}
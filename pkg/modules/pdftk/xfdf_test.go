@@ -0,0 +1,66 @@
+package pdftk
+
+import (
+	"testing"
+)
+
+func TestMarshalXfdf(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		values      map[string]interface{}
+		expectXfdf  string
+		expectError bool
+	}{
+		{
+			scenario: "unsupported value",
+			values: map[string]interface{}{
+				"foo": map[string]interface{}{"bar": "baz"},
+			},
+			expectError: true,
+		},
+		{
+			scenario: "unsupported list item",
+			values: map[string]interface{}{
+				"foo": []interface{}{"bar", 1.0},
+			},
+			expectError: true,
+		},
+		{
+			scenario: "success",
+			values: map[string]interface{}{
+				"name":          "Jane <Doe>",
+				"age":           42.0,
+				"subscribe":     true,
+				"terms":         false,
+				"colors":        []interface{}{"red", "blue"},
+				"address.city":  "Paris",
+				"address.state": "IDF",
+			},
+			expectXfdf: `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+				`<xfdf xmlns="http://ns.adobe.com/xfdf/"><fields>` +
+				`<field name="address"><field name="city"><value>Paris</value></field><field name="state"><value>IDF</value></field></field>` +
+				`<field name="age"><value>42</value></field>` +
+				`<field name="colors"><value>red</value><value>blue</value></field>` +
+				`<field name="name"><value>Jane &lt;Doe&gt;</value></field>` +
+				`<field name="subscribe"><value>Yes</value></field>` +
+				`<field name="terms"><value>Off</value></field>` +
+				`</fields></xfdf>`,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			data, err := marshalXfdf(tc.values)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if string(data) != tc.expectXfdf {
+				t.Errorf("expected '%s' but got '%s'", tc.expectXfdf, string(data))
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package pdftoppm provides an implementation of the gotenberg.PdfEngine
+// interface using the pdftoppm command-line tool from Poppler. This package
+// allows for:
+//
+// 1. The rasterization of PDF pages to PNG, JPEG or WebP images.
+//
+// The paths to the pdftoppm and cwebp binaries must be specified using the
+// PDFTOPPM_BIN_PATH and CWEBP_BIN_PATH environment variables. Otherwise, the
+// module is not available.
+//
+// See: https://poppler.freedesktop.org.
+package pdftoppm
@@ -0,0 +1,50 @@
+package pdftoppm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pageRange is a range of pages to render. A zero value means either the
+// first or the last page of the PDF.
+type pageRange struct {
+	first int
+	last  int
+}
+
+// parsePageRanges returns the ranges of page ranges (e.g., "1-3, 5, 8-").
+// Empty page ranges mean all pages.
+func parsePageRanges(pageRanges string) ([]pageRange, error) {
+	pageRanges = strings.Join(strings.Fields(pageRanges), "")
+
+	if pageRanges == "" {
+		return []pageRange{{}}, nil
+	}
+
+	var ranges []pageRange
+
+	for _, value := range strings.Split(pageRanges, ",") {
+		from, to, isRange := strings.Cut(value, "-")
+
+		first, err := strconv.Atoi(from)
+		if err != nil || first < 1 {
+			return nil, fmt.Errorf("invalid page range '%s'", value)
+		}
+
+		last := first
+		if isRange {
+			last = 0
+			if to != "" {
+				last, err = strconv.Atoi(to)
+				if err != nil || last < first {
+					return nil, fmt.Errorf("invalid page range '%s'", value)
+				}
+			}
+		}
+
+		ranges = append(ranges, pageRange{first: first, last: last})
+	}
+
+	return ranges, nil
+}
@@ -0,0 +1,67 @@
+package pdftoppm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		pageRanges   string
+		expectRanges []pageRange
+		expectError  bool
+	}{
+		{
+			scenario:     "empty page ranges",
+			pageRanges:   "",
+			expectRanges: []pageRange{{}},
+		},
+		{
+			scenario:     "single page",
+			pageRanges:   "2",
+			expectRanges: []pageRange{{first: 2, last: 2}},
+		},
+		{
+			scenario:     "many page ranges",
+			pageRanges:   "1-3, 5, 8-",
+			expectRanges: []pageRange{{first: 1, last: 3}, {first: 5, last: 5}, {first: 8}},
+		},
+		{
+			scenario:    "invalid page number",
+			pageRanges:  "foo",
+			expectError: true,
+		},
+		{
+			scenario:    "page zero",
+			pageRanges:  "0-2",
+			expectError: true,
+		},
+		{
+			scenario:    "reversed page range",
+			pageRanges:  "3-1",
+			expectError: true,
+		},
+		{
+			scenario:    "invalid end of page range",
+			pageRanges:  "1-foo",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ranges, err := parsePageRanges(tc.pageRanges)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(ranges, tc.expectRanges) {
+				t.Errorf("expected %+v but got: %+v", tc.expectRanges, ranges)
+			}
+		})
+	}
+}
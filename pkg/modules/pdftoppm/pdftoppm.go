@@ -0,0 +1,396 @@
+package pdftoppm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func init() {
+	gotenberg.MustRegisterModule(new(PdfToPpm))
+}
+
+// PdfToPpm abstracts the CLI tool pdftoppm from Poppler and implements the
+// [gotenberg.PdfEngine] interface. It relies on the CLI tool cwebp for the
+// WebP images.
+type PdfToPpm struct {
+	binPath      string
+	cwebpBinPath string
+}
+
+// Descriptor returns a [PdfToPpm]'s module descriptor.
+func (engine *PdfToPpm) Descriptor() gotenberg.ModuleDescriptor {
+	return gotenberg.ModuleDescriptor{
+		ID:  "pdftoppm",
+		New: func() gotenberg.Module { return new(PdfToPpm) },
+	}
+}
+
+// Provision sets the module properties. If either the PDFTOPPM_BIN_PATH or
+// the CWEBP_BIN_PATH environment variable is not set, the module is not
+// available.
+func (engine *PdfToPpm) Provision(ctx *gotenberg.Context) error {
+	engine.binPath = os.Getenv("PDFTOPPM_BIN_PATH")
+	engine.cwebpBinPath = os.Getenv("CWEBP_BIN_PATH")
+
+	return nil
+}
+
+// Validate validates the module properties.
+func (engine *PdfToPpm) Validate() error {
+	if !engine.Available() {
+		return nil
+	}
+
+	_, err := os.Stat(engine.binPath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("pdftoppm binary path does not exist: %w", err)
+	}
+
+	_, err = os.Stat(engine.cwebpBinPath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("cwebp binary path does not exist: %w", err)
+	}
+
+	return nil
+}
+
+// Available tells whether the pdftoppm and cwebp binaries are available.
+func (engine *PdfToPpm) Available() bool {
+	return engine.binPath != "" && engine.cwebpBinPath != ""
+}
+
+// Debug returns additional debug data.
+func (engine *PdfToPpm) Debug() map[string]interface{} {
+	debug := make(map[string]interface{})
+
+	cmd := exec.Command(engine.binPath, "-v") //nolint:gosec
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	// pdftoppm prints its version to stderr.
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		debug["version"] = err.Error()
+		return debug
+	}
+
+	debug["version"] = "Unable to determine pdftoppm version"
+
+	lines := strings.Split(string(output), "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "pdftoppm version") {
+			debug["version"] = strings.TrimSpace(strings.TrimPrefix(line, "pdftoppm version"))
+			break
+		}
+	}
+
+	return debug
+}
+
+// Merge is not available in this implementation.
+func (engine *PdfToPpm) Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("merge PDFs with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Split is not available in this implementation.
+func (engine *PdfToPpm) Split(ctx context.Context, logger *zap.Logger, mode gotenberg.SplitMode, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("split PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Flatten is not available in this implementation.
+func (engine *PdfToPpm) Flatten(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("flatten PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Convert is not available in this implementation.
+func (engine *PdfToPpm) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+	return fmt.Errorf("convert PDF to '%+v' with pdftoppm: %w", formats, gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadMetadata is not available in this implementation.
+func (engine *PdfToPpm) ReadMetadata(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("read PDF metadata with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteMetadata is not available in this implementation.
+func (engine *PdfToPpm) WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("write PDF metadata with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Encrypt is not available in this implementation.
+func (engine *PdfToPpm) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	return fmt.Errorf("encrypt PDF using pdftoppm: %w", gotenberg.ErrPdfEncryptionNotSupported)
+}
+
+// EmbedFiles is not available in this implementation.
+func (engine *PdfToPpm) EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error {
+	return fmt.Errorf("embed files with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rotate is not available in this implementation.
+func (engine *PdfToPpm) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *PdfToPpm) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *PdfToPpm) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *PdfToPpm) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *PdfToPpm) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *PdfToPpm) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *PdfToPpm) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *PdfToPpm) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *PdfToPpm) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *PdfToPpm) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *PdfToPpm) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *PdfToPpm) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *PdfToPpm) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *PdfToPpm) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *PdfToPpm) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *PdfToPpm) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *PdfToPpm) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *PdfToPpm) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *PdfToPpm) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *PdfToPpm) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *PdfToPpm) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *PdfToPpm) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *PdfToPpm) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *PdfToPpm) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with pdftoppm: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize renders the pages of a PDF file as images. As pdftoppm does not
+// support WebP, it converts PNG images with cwebp.
+func (engine *PdfToPpm) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	pageRanges, err := parsePageRanges(options.PageRanges)
+	if err != nil {
+		return nil, gotenberg.NewPdfEngineInvalidArgs("pdftoppm", err.Error())
+	}
+
+	var (
+		formatArgs []string
+		ext        string
+	)
+	switch options.Format {
+	case gotenberg.RasterizeFormatPng, gotenberg.RasterizeFormatWebp:
+		formatArgs = append(formatArgs, "-png")
+		ext = ".png"
+	case gotenberg.RasterizeFormatJpeg:
+		formatArgs = append(formatArgs, "-jpeg")
+		if options.Quality > 0 {
+			formatArgs = append(formatArgs, "-jpegopt", fmt.Sprintf("quality=%d", options.Quality))
+		}
+		ext = ".jpg"
+	default:
+		return nil, gotenberg.NewPdfEngineInvalidArgs("pdftoppm", fmt.Sprintf("unsupported image format '%s'", options.Format))
+	}
+
+	// pdftoppm names the images "page-N.ext", with N padded with zeros.
+	outputRoot := fmt.Sprintf("%s/page", outputDirPath)
+
+	for _, pageRange := range pageRanges {
+		var args []string
+		args = append(args, formatArgs...)
+		if options.Dpi > 0 {
+			args = append(args, "-r", strconv.Itoa(options.Dpi))
+		}
+		if pageRange.first > 0 {
+			args = append(args, "-f", strconv.Itoa(pageRange.first))
+		}
+		if pageRange.last > 0 {
+			args = append(args, "-l", strconv.Itoa(pageRange.last))
+		}
+		args = append(args, inputPath, outputRoot)
+
+		cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+		if err != nil {
+			return nil, fmt.Errorf("create command: %w", err)
+		}
+
+		_, err = cmd.Exec()
+		if err != nil {
+			return nil, fmt.Errorf("rasterize PDF with pdftoppm: %w", err)
+		}
+	}
+
+	entries, err := os.ReadDir(outputDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("read output directory: %w", err)
+	}
+
+	var numbers []int
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, "page-") || filepath.Ext(name) != ext {
+			continue
+		}
+
+		number, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "page-"), ext))
+		if err != nil {
+			return nil, fmt.Errorf("parse page number of image '%s': %w", name, err)
+		}
+
+		err = os.Rename(filepath.Join(outputDirPath, name), fmt.Sprintf("%s/%d%s", outputDirPath, number, ext))
+		if err != nil {
+			return nil, fmt.Errorf("rename image '%s': %w", name, err)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	sort.Ints(numbers)
+
+	outputPaths := make([]string, len(numbers))
+	for i, number := range numbers {
+		outputPaths[i] = fmt.Sprintf("%s/%d%s", outputDirPath, number, ext)
+
+		if options.Format != gotenberg.RasterizeFormatWebp {
+			continue
+		}
+
+		outputPaths[i], err = engine.toWebp(ctx, logger, options.Quality, outputPaths[i])
+		if err != nil {
+			return nil, fmt.Errorf("convert image to WebP: %w", err)
+		}
+	}
+
+	return outputPaths, nil
+}
+
+// toWebp converts a PNG image to WebP with cwebp, removes the PNG image and
+// returns the path of the WebP image.
+func (engine *PdfToPpm) toWebp(ctx context.Context, logger *zap.Logger, quality int, inputPath string) (string, error) {
+	outputPath := strings.TrimSuffix(inputPath, filepath.Ext(inputPath)) + ".webp"
+
+	var args []string
+	args = append(args, "-quiet")
+	if quality > 0 {
+		args = append(args, "-q", strconv.Itoa(quality))
+	}
+	args = append(args, inputPath, "-o", outputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.cwebpBinPath, args...)
+	if err != nil {
+		return "", fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return "", fmt.Errorf("convert image with cwebp: %w", err)
+	}
+
+	err = os.Remove(inputPath)
+	if err != nil {
+		return "", fmt.Errorf("remove PNG image: %w", err)
+	}
+
+	return outputPath, nil
+}
+
+// Interface guards.
+var (
+	_ gotenberg.Module            = (*PdfToPpm)(nil)
+	_ gotenberg.Provisioner       = (*PdfToPpm)(nil)
+	_ gotenberg.Validator         = (*PdfToPpm)(nil)
+	_ gotenberg.Debuggable        = (*PdfToPpm)(nil)
+	_ gotenberg.PdfEngine         = (*PdfToPpm)(nil)
+	_ gotenberg.OptionalPdfEngine = (*PdfToPpm)(nil)
+)
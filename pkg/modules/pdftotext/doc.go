@@ -0,0 +1,12 @@
+// Package pdftotext provides an implementation of the gotenberg.PdfEngine
+// interface using the pdftotext command-line tool from Poppler. This package
+// allows for:
+//
+// 1. The extraction of text from PDF files.
+//
+// The path to the pdftotext binary must be specified using the
+// PDFTOTEXT_BIN_PATH environment variable. Otherwise, the module is not
+// available.
+//
+// See: https://poppler.freedesktop.org.
+package pdftotext
@@ -0,0 +1,53 @@
+package pdftotext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parsePageRanges returns the page numbers of page ranges (e.g.,
+// "1-3, 5, 8-"), in the given order. Empty page ranges mean all pages.
+func parsePageRanges(pageRanges string, pageCount int) ([]int, error) {
+	pageRanges = strings.Join(strings.Fields(pageRanges), "")
+
+	if pageRanges == "" {
+		numbers := make([]int, pageCount)
+		for i := range numbers {
+			numbers[i] = i + 1
+		}
+		return numbers, nil
+	}
+
+	var numbers []int
+
+	for _, pageRange := range strings.Split(pageRanges, ",") {
+		from, to, isRange := strings.Cut(pageRange, "-")
+
+		start, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page range '%s'", pageRange)
+		}
+
+		end := start
+		if isRange {
+			end = pageCount
+			if to != "" {
+				end, err = strconv.Atoi(to)
+				if err != nil {
+					return nil, fmt.Errorf("invalid page range '%s'", pageRange)
+				}
+			}
+		}
+
+		if start < 1 || start > end || end > pageCount {
+			return nil, fmt.Errorf("page range '%s' exceeds page count %d", pageRange, pageCount)
+		}
+
+		for number := start; number <= end; number++ {
+			numbers = append(numbers, number)
+		}
+	}
+
+	return numbers, nil
+}
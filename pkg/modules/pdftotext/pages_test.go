@@ -0,0 +1,75 @@
+package pdftotext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario      string
+		pageRanges    string
+		pageCount     int
+		expectNumbers []int
+		expectError   bool
+	}{
+		{
+			scenario:      "all pages",
+			pageRanges:    "",
+			pageCount:     3,
+			expectNumbers: []int{1, 2, 3},
+		},
+		{
+			scenario:      "single pages and ranges",
+			pageRanges:    "1-2, 4, 6-",
+			pageCount:     7,
+			expectNumbers: []int{1, 2, 4, 6, 7},
+		},
+		{
+			scenario:    "invalid start",
+			pageRanges:  "foo",
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario:    "invalid end",
+			pageRanges:  "1-foo",
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario:    "reversed range",
+			pageRanges:  "3-1",
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario:    "page zero",
+			pageRanges:  "0",
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario:    "exceeds page count",
+			pageRanges:  "2-4",
+			pageCount:   3,
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			numbers, err := parsePageRanges(tc.pageRanges, tc.pageCount)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(numbers, tc.expectNumbers) {
+				t.Errorf("expected %v but got %v", tc.expectNumbers, numbers)
+			}
+		})
+	}
+}
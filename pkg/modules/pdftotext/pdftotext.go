@@ -0,0 +1,305 @@
+package pdftotext
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func init() {
+	gotenberg.MustRegisterModule(new(PdfToText))
+}
+
+// PdfToText abstracts the CLI tool pdftotext from Poppler and implements the
+// [gotenberg.PdfEngine] interface.
+type PdfToText struct {
+	binPath string
+}
+
+// Descriptor returns a [PdfToText]'s module descriptor.
+func (engine *PdfToText) Descriptor() gotenberg.ModuleDescriptor {
+	return gotenberg.ModuleDescriptor{
+		ID:  "pdftotext",
+		New: func() gotenberg.Module { return new(PdfToText) },
+	}
+}
+
+// Provision sets the module properties. If the PDFTOTEXT_BIN_PATH environment
+// variable is not set, the module is not available.
+func (engine *PdfToText) Provision(ctx *gotenberg.Context) error {
+	engine.binPath = os.Getenv("PDFTOTEXT_BIN_PATH")
+
+	return nil
+}
+
+// Validate validates the module properties.
+func (engine *PdfToText) Validate() error {
+	if !engine.Available() {
+		return nil
+	}
+
+	_, err := os.Stat(engine.binPath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("pdftotext binary path does not exist: %w", err)
+	}
+
+	return nil
+}
+
+// Available tells whether the pdftotext binary is available.
+func (engine *PdfToText) Available() bool {
+	return engine.binPath != ""
+}
+
+// Debug returns additional debug data.
+func (engine *PdfToText) Debug() map[string]interface{} {
+	debug := make(map[string]interface{})
+
+	cmd := exec.Command(engine.binPath, "-v") //nolint:gosec
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	// pdftotext prints its version to stderr.
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		debug["version"] = err.Error()
+		return debug
+	}
+
+	debug["version"] = "Unable to determine pdftotext version"
+
+	lines := strings.Split(string(output), "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "pdftotext version") {
+			debug["version"] = strings.TrimSpace(strings.TrimPrefix(line, "pdftotext version"))
+			break
+		}
+	}
+
+	return debug
+}
+
+// Merge is not available in this implementation.
+func (engine *PdfToText) Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("merge PDFs with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Split is not available in this implementation.
+func (engine *PdfToText) Split(ctx context.Context, logger *zap.Logger, mode gotenberg.SplitMode, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("split PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Flatten is not available in this implementation.
+func (engine *PdfToText) Flatten(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("flatten PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Convert is not available in this implementation.
+func (engine *PdfToText) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+	return fmt.Errorf("convert PDF to '%+v' with pdftotext: %w", formats, gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadMetadata is not available in this implementation.
+func (engine *PdfToText) ReadMetadata(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("read PDF metadata with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteMetadata is not available in this implementation.
+func (engine *PdfToText) WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("write PDF metadata with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Encrypt is not available in this implementation.
+func (engine *PdfToText) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	return fmt.Errorf("encrypt PDF using pdftotext: %w", gotenberg.ErrPdfEncryptionNotSupported)
+}
+
+// EmbedFiles is not available in this implementation.
+func (engine *PdfToText) EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error {
+	return fmt.Errorf("embed files with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rotate is not available in this implementation.
+func (engine *PdfToText) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *PdfToText) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *PdfToText) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *PdfToText) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *PdfToText) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *PdfToText) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *PdfToText) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *PdfToText) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *PdfToText) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *PdfToText) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *PdfToText) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *PdfToText) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *PdfToText) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *PdfToText) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *PdfToText) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *PdfToText) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *PdfToText) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *PdfToText) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *PdfToText) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *PdfToText) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *PdfToText) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *PdfToText) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *PdfToText) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *PdfToText) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with pdftotext: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText extracts the text of the pages of a PDF file, in reading
+// order. Empty page ranges mean all pages.
+func (engine *PdfToText) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	outputPath := inputPath + ".txt"
+	defer func() {
+		err := os.Remove(outputPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove text file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, "-enc", "UTF-8")
+	args = append(args, inputPath, outputPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("extract text with pdftotext: %w", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("read text file: %w", err)
+	}
+
+	// Each page ends with a form feed.
+	pages := strings.Split(strings.TrimSuffix(string(data), "\f"), "\f")
+
+	numbers, err := parsePageRanges(pageRanges, len(pages))
+	if err != nil {
+		return nil, gotenberg.NewPdfEngineInvalidArgs("pdftotext", err.Error())
+	}
+
+	texts := make([]gotenberg.PdfPageText, len(numbers))
+	for i, number := range numbers {
+		texts[i] = gotenberg.PdfPageText{
+			Page: number,
+			Text: pages[number-1],
+		}
+	}
+
+	return texts, nil
+}
+
+// Interface guards.
+var (
+	_ gotenberg.Module            = (*PdfToText)(nil)
+	_ gotenberg.Provisioner       = (*PdfToText)(nil)
+	_ gotenberg.Validator         = (*PdfToText)(nil)
+	_ gotenberg.Debuggable        = (*PdfToText)(nil)
+	_ gotenberg.PdfEngine         = (*PdfToText)(nil)
+	_ gotenberg.OptionalPdfEngine = (*PdfToText)(nil)
+)
@@ -0,0 +1,14 @@
+// Package pyhanko provides an implementation of the gotenberg.PdfEngine
+// interface using the pyHanko command-line tool. This package allows for:
+//
+// 1. The digital signing of PDF files (PAdES), with an optional RFC 3161
+// timestamp.
+// 2. The verification of the digital signatures of PDF files.
+//
+// The path to the pyHanko binary must be specified using the
+// PYHANKO_BIN_PATH environment variable, and the path to the Python binary
+// of its installation using the PYHANKO_PYTHON_BIN_PATH environment variable.
+// Otherwise, the module is not available.
+//
+// See: https://github.com/MatthiasValvekens/pyHanko.
+package pyhanko
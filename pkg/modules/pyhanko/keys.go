@@ -0,0 +1,104 @@
+package pyhanko
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// signingKeys gathers the paths to a signing key and its certificates, either
+// as a PKCS#12 bundle or as PEM files.
+type signingKeys struct {
+	pkcs12Path string
+	keyPath    string
+	certPath   string
+	chainPaths []string
+}
+
+// isEmpty tells if there is no signing key.
+func (keys signingKeys) isEmpty() bool {
+	return keys.pkcs12Path == "" && keys.keyPath == ""
+}
+
+// validate checks that the signing keys are either a PKCS#12 bundle, or a
+// PEM private key with its certificate.
+func (keys signingKeys) validate() error {
+	if keys.pkcs12Path != "" {
+		if keys.keyPath != "" || keys.certPath != "" {
+			return errors.New("either a PKCS#12 bundle or a PEM private key and certificate are allowed, not both")
+		}
+		return nil
+	}
+
+	if keys.keyPath == "" && keys.certPath != "" {
+		return errors.New("the PEM private key is missing")
+	}
+
+	if keys.keyPath != "" && keys.certPath == "" {
+		return errors.New("the PEM certificate is missing")
+	}
+
+	return nil
+}
+
+// classifyKeys sorts files into signing keys. Files with the .p12 or .pfx
+// extension are PKCS#12 bundles; other files must be PEM files, either a
+// private key or certificates. The first certificate is the signer's one,
+// and the next ones form its chain.
+func classifyKeys(paths []string) (signingKeys, error) {
+	var keys signingKeys
+
+	for _, path := range paths {
+		filename := filepath.Base(path)
+
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".p12", ".pfx":
+			if keys.pkcs12Path != "" {
+				return signingKeys{}, errors.New("only one PKCS#12 bundle is allowed")
+			}
+			keys.pkcs12Path = path
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return signingKeys{}, fmt.Errorf("read '%s': %w", filename, err)
+		}
+
+		switch {
+		case bytes.Contains(data, []byte("PRIVATE KEY-----")):
+			if keys.keyPath != "" {
+				return signingKeys{}, errors.New("only one PEM private key is allowed")
+			}
+			keys.keyPath = path
+		case bytes.Contains(data, []byte("-----BEGIN CERTIFICATE-----")):
+			if keys.certPath == "" && keys.pkcs12Path == "" {
+				keys.certPath = path
+			} else {
+				keys.chainPaths = append(keys.chainPaths, path)
+			}
+		default:
+			return signingKeys{}, fmt.Errorf("'%s' is neither a PKCS#12 bundle, a PEM private key nor a PEM certificate", filename)
+		}
+	}
+
+	// Certificates uploaded before the PKCS#12 bundle belong to its chain.
+	if keys.pkcs12Path != "" && keys.certPath != "" {
+		keys.chainPaths = append([]string{keys.certPath}, keys.chainPaths...)
+		keys.certPath = ""
+	}
+
+	if keys.isEmpty() {
+		return signingKeys{}, errors.New("no signing key")
+	}
+
+	err := keys.validate()
+	if err != nil {
+		return signingKeys{}, err
+	}
+
+	return keys, nil
+}
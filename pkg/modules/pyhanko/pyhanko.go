@@ -0,0 +1,491 @@
+package pyhanko
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"github.com/dlclark/regexp2"
+	flag "github.com/spf13/pflag"
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func init() {
+	gotenberg.MustRegisterModule(new(PyHanko))
+}
+
+// verifyScript verifies the digital signatures of a PDF file with the pyHanko
+// library, as the CLI does not output a machine-readable report.
+//
+//go:embed verify.py
+var verifyScript string
+
+// PyHanko abstracts the CLI tool pyHanko and implements the
+// [gotenberg.PdfEngine] interface.
+type PyHanko struct {
+	binPath            string
+	pythonBinPath      string
+	trustBundlePath    string
+	keys               signingKeys
+	passphrase         string
+	allowUploadKeys    bool
+	timestampAllowList *regexp2.Regexp
+	timestampDenyList  *regexp2.Regexp
+}
+
+// Descriptor returns a [PyHanko]'s module descriptor.
+func (engine *PyHanko) Descriptor() gotenberg.ModuleDescriptor {
+	return gotenberg.ModuleDescriptor{
+		ID: "pyhanko",
+		FlagSet: func() *flag.FlagSet {
+			fs := flag.NewFlagSet("pyhanko", flag.ExitOnError)
+			fs.String("pyhanko-pkcs12-file", "", "Set the path to the PKCS#12 bundle with the signing key and its certificates")
+			fs.String("pyhanko-key-file", "", "Set the path to the PEM signing key")
+			fs.String("pyhanko-cert-file", "", "Set the path to the PEM certificate of the signing key")
+			fs.StringSlice("pyhanko-chain-files", make([]string, 0), "Set the paths to the PEM certificates of the chain of the signing key")
+			fs.String("pyhanko-passphrase", "", "Set the passphrase of the signing key")
+			fs.Bool("pyhanko-allow-upload-keys", false, "Allow uploading the signing key and its certificates with the request")
+			fs.String("pyhanko-timestamp-allow-list", "", "Set the allowed URLs for the timestamp authorities using a regular expression")
+			fs.String("pyhanko-timestamp-deny-list", "", "Set the denied URLs for the timestamp authorities using a regular expression")
+			fs.String("pyhanko-trust-bundle-file", "", "Set the path to the PEM bundle of the trusted certificate authorities for verifying signatures")
+
+			return fs
+		}(),
+		New: func() gotenberg.Module { return new(PyHanko) },
+	}
+}
+
+// Provision sets the module properties. If either the PYHANKO_BIN_PATH or
+// the PYHANKO_PYTHON_BIN_PATH environment variable is not set, the module is
+// not available.
+func (engine *PyHanko) Provision(ctx *gotenberg.Context) error {
+	flags := ctx.ParsedFlags()
+
+	engine.binPath = os.Getenv("PYHANKO_BIN_PATH")
+	engine.pythonBinPath = os.Getenv("PYHANKO_PYTHON_BIN_PATH")
+	engine.keys = signingKeys{
+		pkcs12Path: flags.MustString("pyhanko-pkcs12-file"),
+		keyPath:    flags.MustString("pyhanko-key-file"),
+		certPath:   flags.MustString("pyhanko-cert-file"),
+		chainPaths: flags.MustStringSlice("pyhanko-chain-files"),
+	}
+	engine.passphrase = flags.MustString("pyhanko-passphrase")
+	engine.allowUploadKeys = flags.MustBool("pyhanko-allow-upload-keys")
+	engine.timestampAllowList = flags.MustRegexp("pyhanko-timestamp-allow-list")
+	engine.timestampDenyList = flags.MustRegexp("pyhanko-timestamp-deny-list")
+	engine.trustBundlePath = flags.MustString("pyhanko-trust-bundle-file")
+
+	return nil
+}
+
+// Validate validates the module properties.
+func (engine *PyHanko) Validate() error {
+	if engine.Available() {
+		_, err := os.Stat(engine.binPath)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("pyHanko binary path does not exist: %w", err)
+		}
+
+		_, err = os.Stat(engine.pythonBinPath)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("pyHanko Python binary path does not exist: %w", err)
+		}
+	}
+
+	if engine.trustBundlePath != "" {
+		_, err := os.Stat(engine.trustBundlePath)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("trust bundle file '%s' does not exist: %w", engine.trustBundlePath, err)
+		}
+	}
+
+	err := engine.keys.validate()
+	if err != nil {
+		return fmt.Errorf("invalid signing keys: %w", err)
+	}
+
+	paths := append([]string{engine.keys.pkcs12Path, engine.keys.keyPath, engine.keys.certPath}, engine.keys.chainPaths...)
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		_, err = os.Stat(path)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("signing key file '%s' does not exist: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// SystemMessages returns one message about the signing keys.
+func (engine *PyHanko) SystemMessages() []string {
+	if engine.keys.isEmpty() {
+		return []string{"no signing keys configured"}
+	}
+
+	return []string{"signing keys configured"}
+}
+
+// Available tells whether the pyHanko and Python binaries are available.
+func (engine *PyHanko) Available() bool {
+	return engine.binPath != "" && engine.pythonBinPath != ""
+}
+
+// Debug returns additional debug data.
+func (engine *PyHanko) Debug() map[string]interface{} {
+	debug := make(map[string]interface{})
+
+	cmd := exec.Command(engine.binPath, "--version") //nolint:gosec
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	output, err := cmd.Output()
+	if err != nil {
+		debug["version"] = err.Error()
+		return debug
+	}
+
+	debug["version"] = "Unable to determine pyHanko version"
+
+	// e.g., "pyhanko, version 0.25.3".
+	_, version, ok := strings.Cut(strings.TrimSpace(string(output)), "version ")
+	if ok {
+		debug["version"] = version
+	}
+
+	return debug
+}
+
+// Merge is not available in this implementation.
+func (engine *PyHanko) Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("merge PDFs with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Split is not available in this implementation.
+func (engine *PyHanko) Split(ctx context.Context, logger *zap.Logger, mode gotenberg.SplitMode, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("split PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Flatten is not available in this implementation.
+func (engine *PyHanko) Flatten(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("flatten PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Convert is not available in this implementation.
+func (engine *PyHanko) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+	return fmt.Errorf("convert PDF to '%+v' with pyHanko: %w", formats, gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadMetadata is not available in this implementation.
+func (engine *PyHanko) ReadMetadata(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("read PDF metadata with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteMetadata is not available in this implementation.
+func (engine *PyHanko) WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("write PDF metadata with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Encrypt is not available in this implementation.
+func (engine *PyHanko) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	return fmt.Errorf("encrypt PDF using pyHanko: %w", gotenberg.ErrPdfEncryptionNotSupported)
+}
+
+// EmbedFiles is not available in this implementation.
+func (engine *PyHanko) EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error {
+	return fmt.Errorf("embed files with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rotate is not available in this implementation.
+func (engine *PyHanko) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *PyHanko) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *PyHanko) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *PyHanko) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *PyHanko) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *PyHanko) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *PyHanko) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *PyHanko) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *PyHanko) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *PyHanko) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *PyHanko) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *PyHanko) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *PyHanko) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *PyHanko) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *PyHanko) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *PyHanko) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *PyHanko) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *PyHanko) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *PyHanko) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign digitally signs a PDF file with a PAdES signature.
+func (engine *PyHanko) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	keys := engine.keys
+	passphrase := engine.passphrase
+
+	if len(options.KeyPaths) > 0 {
+		if !engine.allowUploadKeys {
+			return gotenberg.NewPdfEngineInvalidArgs("pyHanko", "uploading signing keys is not allowed")
+		}
+
+		var err error
+		keys, err = classifyKeys(options.KeyPaths)
+		if err != nil {
+			return gotenberg.NewPdfEngineInvalidArgs("pyHanko", err.Error())
+		}
+		passphrase = options.Passphrase
+	}
+
+	if keys.isEmpty() {
+		return gotenberg.NewPdfEngineInvalidArgs("pyHanko", "no signing keys configured")
+	}
+
+	fieldName := options.FieldName
+	if fieldName == "" {
+		fieldName = "Signature1"
+	}
+
+	field := fieldName
+	if options.Visible {
+		field = fmt.Sprintf("%d/%g,%g,%g,%g/%s", options.Page, options.Rect[0], options.Rect[1], options.Rect[2], options.Rect[3], fieldName)
+	}
+
+	var args []string
+	args = append(args, "sign", "addsig", "--use-pades", "--field", field)
+
+	if options.Reason != "" {
+		args = append(args, "--reason", options.Reason)
+	}
+
+	if options.Location != "" {
+		args = append(args, "--location", options.Location)
+	}
+
+	if options.ContactInfo != "" {
+		args = append(args, "--contact-info", options.ContactInfo)
+	}
+
+	if options.TimestampUrl != "" {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return errors.New("context has no deadline")
+		}
+
+		err := gotenberg.FilterDeadline(engine.timestampAllowList, engine.timestampDenyList, options.TimestampUrl, deadline)
+		if errors.Is(err, gotenberg.ErrFiltered) {
+			return gotenberg.NewPdfEngineInvalidArgs("pyHanko", fmt.Sprintf("timestamp URL '%s' is not allowed", options.TimestampUrl))
+		}
+		if err != nil {
+			return fmt.Errorf("filter timestamp URL: %w", err)
+		}
+
+		args = append(args, "--timestamp-url", options.TimestampUrl)
+	}
+
+	// pyHanko reads the passphrase from a file, as it would prompt for it
+	// otherwise.
+	passPath := inputPath + ".pass"
+	err := os.WriteFile(passPath, []byte(passphrase), 0o600)
+	if err != nil {
+		return fmt.Errorf("write passphrase file: %w", err)
+	}
+	defer func() {
+		err := os.Remove(passPath)
+		if err != nil {
+			logger.Error(fmt.Sprintf("remove passphrase file: %s", err))
+		}
+	}()
+
+	outputPath := inputPath + ".signed.pdf"
+
+	if keys.pkcs12Path != "" {
+		args = append(args, "pkcs12")
+		for _, chainPath := range keys.chainPaths {
+			args = append(args, "--chain", chainPath)
+		}
+		args = append(args, "--passfile", passPath, inputPath, outputPath, keys.pkcs12Path)
+	} else {
+		args = append(args, "pemder", "--key", keys.keyPath, "--cert", keys.certPath)
+		for _, chainPath := range keys.chainPaths {
+			args = append(args, "--chain", chainPath)
+		}
+		if passphrase == "" {
+			args = append(args, "--no-pass")
+		} else {
+			args = append(args, "--passfile", passPath)
+		}
+		args = append(args, inputPath, outputPath)
+	}
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("sign PDF with pyHanko: %w", err)
+	}
+
+	err = os.Rename(outputPath, inputPath)
+	if err != nil {
+		return fmt.Errorf("rename signed PDF: %w", err)
+	}
+
+	return nil
+}
+
+// VerifySignatures verifies the digital signatures of a PDF file. Without a
+// trust bundle, no signature is trusted.
+func (engine *PyHanko) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove pyHanko JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, "-c", verifyScript, inputPath, jsonPath)
+
+	if engine.trustBundlePath != "" {
+		args = append(args, engine.trustBundlePath)
+	}
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.pythonBinPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("verify PDF signatures with pyHanko: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("read pyHanko JSON: %w", err)
+	}
+
+	signatures := make([]gotenberg.PdfSignature, 0)
+	err = json.Unmarshal(data, &signatures)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pyHanko JSON: %w", err)
+	}
+
+	return signatures, nil
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *PyHanko) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair is not available in this implementation.
+func (engine *PyHanko) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *PyHanko) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *PyHanko) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with pyHanko: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Interface guards.
+var (
+	_ gotenberg.Module            = (*PyHanko)(nil)
+	_ gotenberg.Provisioner       = (*PyHanko)(nil)
+	_ gotenberg.Validator         = (*PyHanko)(nil)
+	_ gotenberg.SystemLogger      = (*PyHanko)(nil)
+	_ gotenberg.Debuggable        = (*PyHanko)(nil)
+	_ gotenberg.PdfEngine         = (*PyHanko)(nil)
+	_ gotenberg.OptionalPdfEngine = (*PyHanko)(nil)
+)
@@ -0,0 +1,98 @@
+# Verifies the digital signatures of a PDF file with pyHanko and writes the
+# report as JSON.
+#
+# Usage: python verify.py <input.pdf> <output.json> [<trust-bundle.pem>]
+
+import json
+import sys
+
+from asn1crypto import pem, x509
+from pyhanko.pdf_utils.reader import PdfFileReader
+from pyhanko.sign.validation import validate_pdf_signature
+from pyhanko_certvalidator import ValidationContext
+
+
+def load_certificates(path):
+    with open(path, "rb") as f:
+        data = f.read()
+
+    if not pem.detect(data):
+        return [x509.Certificate.load(data)]
+
+    return [
+        x509.Certificate.load(der)
+        for _, _, der in pem.unarmor(data, multiple=True)
+    ]
+
+
+def certificate_chain(signer, others):
+    # Follows the issuers from the signer's certificate, as far as the
+    # signature embeds them.
+    chain = [signer]
+    current = signer
+
+    # The self_signed property is either "no", "maybe" or "yes".
+    while current.self_signed == "no" and len(chain) <= len(others):
+        issuer = next(
+            (cert for cert in others if cert.subject == current.issuer),
+            None,
+        )
+        if issuer is None:
+            break
+
+        chain.append(issuer)
+        current = issuer
+
+    return chain
+
+
+def describe_certificate(cert):
+    return {
+        "subject": cert.subject.human_friendly,
+        "issuer": cert.issuer.human_friendly,
+        "serialNumber": format(cert.serial_number, "X"),
+        "notBefore": cert.not_valid_before.isoformat(),
+        "notAfter": cert.not_valid_after.isoformat(),
+    }
+
+
+def main(input_path, output_path, trust_bundle_path=None):
+    trust_roots = []
+    if trust_bundle_path:
+        trust_roots = load_certificates(trust_bundle_path)
+
+    signatures = []
+
+    with open(input_path, "rb") as f:
+        reader = PdfFileReader(f, strict=False)
+
+        for embedded in reader.embedded_signatures:
+            context = ValidationContext(trust_roots=trust_roots, allow_fetching=False)
+            status = validate_pdf_signature(embedded, context)
+
+            others = list(embedded.other_embedded_certs)
+            chain = certificate_chain(embedded.signer_cert, others)
+
+            signing_time = status.signer_reported_dt
+            modification_level = status.modification_level
+
+            signatures.append(
+                {
+                    "fieldName": embedded.field_name,
+                    "certificates": [describe_certificate(cert) for cert in chain],
+                    "signingTime": signing_time.isoformat() if signing_time else None,
+                    "intact": bool(status.intact and status.valid),
+                    "coversWholeDocument": status.coverage is not None
+                    and status.coverage.name == "ENTIRE_FILE",
+                    "modifiedAfterSigning": modification_level is None
+                    or modification_level.name != "NONE",
+                    "trusted": bool(status.trusted),
+                }
+            )
+
+    with open(output_path, "w") as f:
+        json.dump(signatures, f)
+
+
+if __name__ == "__main__":
+    main(*sys.argv[1:4])
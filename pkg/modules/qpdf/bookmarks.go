@@ -0,0 +1,47 @@
+package qpdf
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// qpdfOutline is an entry of the "outlines" key of the QPDF JSON output
+// (version 2).
+type qpdfOutline struct {
+	Title            string        `json:"title"`
+	DestPagePosFrom1 *int          `json:"destpageposfrom1"`
+	Kids             []qpdfOutline `json:"kids"`
+}
+
+// parseBookmarks builds the [gotenberg.Bookmark] tree from the QPDF JSON
+// output. Entries without a page target have a zero page.
+func parseBookmarks(data []byte) ([]gotenberg.Bookmark, error) {
+	var output struct {
+		Outlines []qpdfOutline `json:"outlines"`
+	}
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	return toBookmarks(output.Outlines), nil
+}
+
+func toBookmarks(outlines []qpdfOutline) []gotenberg.Bookmark {
+	bookmarks := make([]gotenberg.Bookmark, len(outlines))
+
+	for i, outline := range outlines {
+		bookmarks[i].Title = outline.Title
+		if outline.DestPagePosFrom1 != nil {
+			bookmarks[i].Page = *outline.DestPagePosFrom1
+		}
+		if len(outline.Kids) > 0 {
+			bookmarks[i].Children = toBookmarks(outline.Kids)
+		}
+	}
+
+	return bookmarks
+}
@@ -0,0 +1,72 @@
+package qpdf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestParseBookmarks(t *testing.T) {
+	for _, tc := range []struct {
+		scenario        string
+		data            string
+		expectBookmarks []gotenberg.Bookmark
+		expectError     bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			expectError: true,
+		},
+		{
+			scenario:        "no outlines",
+			data:            `{"outlines":[]}`,
+			expectBookmarks: []gotenberg.Bookmark{},
+		},
+		{
+			scenario: "success",
+			data: `{
+				"outlines": [
+					{
+						"object": "10 0 R",
+						"title": "Chapter 1",
+						"destpageposfrom1": 1,
+						"kids": [
+							{"object": "11 0 R", "title": "Section 1.1", "destpageposfrom1": 2, "kids": []},
+							{"object": "12 0 R", "title": "No target", "destpageposfrom1": null, "kids": []}
+						]
+					},
+					{"object": "13 0 R", "title": "Chapter 2", "destpageposfrom1": 3, "kids": []}
+				]
+			}`,
+			expectBookmarks: []gotenberg.Bookmark{
+				{
+					Title: "Chapter 1",
+					Page:  1,
+					Children: []gotenberg.Bookmark{
+						{Title: "Section 1.1", Page: 2},
+						{Title: "No target"},
+					},
+				},
+				{Title: "Chapter 2", Page: 3},
+			},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			bookmarks, err := parseBookmarks([]byte(tc.data))
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(bookmarks, tc.expectBookmarks) {
+				t.Errorf("expected %+v but got: %+v", tc.expectBookmarks, bookmarks)
+			}
+		})
+	}
+}
@@ -4,6 +4,15 @@
 // 1. The merging of PDF files.
 // 2. The splitting of PDF files.
 // 3. Flattening of PDF files
+// 4. The rotation of PDF pages.
+// 5. The overlaying and underlaying of PDF pages.
+// 6. The retrieval of PDF information.
+// 7. The optimization of PDF files.
+// 8. The decryption of PDF files.
+// 9. The reading of bookmarks.
+// 10. The reading of form fields.
+// 11. The sanitization of PDF files.
+// 12. The deletion, reordering and duplication of PDF pages.
 //
 // The path to the QPDF binary must be specified using the QPDK_BIN_PATH
 // environment variable.
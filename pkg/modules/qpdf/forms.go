@@ -0,0 +1,201 @@
+package qpdf
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// Field flags, see the PDF specification (section 12.7.3.1).
+const (
+	fieldFlagReadOnly = 1 << 0
+	fieldFlagRequired = 1 << 1
+)
+
+// qpdfFormJson represents the subset of the QPDF JSON output (version 2)
+// required to build a list of [gotenberg.FormField].
+type qpdfFormJson struct {
+	AcroForm struct {
+		Fields []qpdfFormField `json:"fields"`
+	} `json:"acroform"`
+	Qpdf []json.RawMessage `json:"qpdf"`
+}
+
+// qpdfFormField is an entry of the "acroform.fields" key of the QPDF JSON
+// output. There is one entry per widget annotation, so that a field with
+// many widgets (e.g., a group of radio buttons) has many entries.
+type qpdfFormField struct {
+	FullName      string      `json:"fullname"`
+	FieldType     string      `json:"fieldtype"`
+	FieldFlags    int         `json:"fieldflags"`
+	Value         interface{} `json:"value"`
+	DefaultValue  interface{} `json:"defaultvalue"`
+	Choices       []string    `json:"choices"`
+	IsCheckbox    bool        `json:"ischeckbox"`
+	IsRadioButton bool        `json:"isradiobutton"`
+	IsChoice      bool        `json:"ischoice"`
+	PagePosFrom1  int         `json:"pageposfrom1"`
+	Annotation    struct {
+		Object string `json:"object"`
+	} `json:"annotation"`
+}
+
+// parseFormFields builds the list of [gotenberg.FormField] from the QPDF JSON
+// output. The page and the position of a field are those of its first
+// widget.
+func parseFormFields(data []byte) ([]gotenberg.FormField, error) {
+	var output qpdfFormJson
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	if len(output.Qpdf) != 2 {
+		return nil, fmt.Errorf("expected 2 entries for the 'qpdf' key, got %d", len(output.Qpdf))
+	}
+
+	var objects qpdfObjects
+	err = json.Unmarshal(output.Qpdf[1], &objects)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON objects: %w", err)
+	}
+
+	fields := make([]gotenberg.FormField, 0, len(output.AcroForm.Fields))
+	indexes := make(map[string]int)
+
+	for _, entry := range output.AcroForm.Fields {
+		widget := objects.dict(entry.Annotation.Object)
+
+		i, ok := indexes[entry.FullName]
+		if ok {
+			// Another widget of the same field.
+			if entry.IsCheckbox || entry.IsRadioButton {
+				for _, state := range onStates(objects, widget) {
+					if !slices.Contains(fields[i].Options, state) {
+						fields[i].Options = append(fields[i].Options, state)
+					}
+				}
+			}
+			continue
+		}
+
+		field := gotenberg.FormField{
+			Name:         entry.FullName,
+			Value:        formValue(entry.Value),
+			DefaultValue: formValue(entry.DefaultValue),
+			ReadOnly:     entry.FieldFlags&fieldFlagReadOnly != 0,
+			Required:     entry.FieldFlags&fieldFlagRequired != 0,
+			Page:         entry.PagePosFrom1,
+		}
+
+		switch {
+		case entry.FieldType == "/Sig":
+			field.Type = gotenberg.FormFieldTypeSignature
+		case entry.IsCheckbox:
+			field.Type = gotenberg.FormFieldTypeCheckbox
+			field.Value = isOn(field.Value)
+			field.DefaultValue = isOn(field.DefaultValue)
+			field.Options = onStates(objects, widget)
+		case entry.IsRadioButton:
+			field.Type = gotenberg.FormFieldTypeRadio
+			field.Options = onStates(objects, widget)
+		case entry.FieldType == "/Btn":
+			field.Type = gotenberg.FormFieldTypeButton
+		case entry.IsChoice:
+			field.Type = gotenberg.FormFieldTypeChoice
+			for _, choice := range entry.Choices {
+				field.Options = append(field.Options, decodeString(choice))
+			}
+		default:
+			field.Type = gotenberg.FormFieldTypeText
+		}
+
+		if widget != nil {
+			rect, ok := objects.rectangle(objects.resolve(widget["/Rect"]))
+			if ok {
+				field.Rect = rect
+			}
+		}
+
+		indexes[entry.FullName] = len(fields)
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// onStates returns the names of the "on" appearance states of a checkbox or
+// radio button widget.
+func onStates(objects qpdfObjects, widget map[string]interface{}) []string {
+	if widget == nil {
+		return nil
+	}
+
+	appearances := objects.dict(widget["/AP"])
+	if appearances == nil {
+		return nil
+	}
+
+	var states []string
+	for state := range objects.dict(appearances["/N"]) {
+		if state != "/Off" {
+			states = append(states, decodeString(state))
+		}
+	}
+	sort.Strings(states)
+
+	return states
+}
+
+// formValue converts the JSON representation of a field value, either a
+// string, a name or an array of strings.
+func formValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return decodeString(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			str, ok := item.(string)
+			if ok {
+				values = append(values, decodeString(str))
+			}
+		}
+		return values
+	default:
+		return value
+	}
+}
+
+// decodeString converts the JSON representation of a PDF string (e.g.,
+// "u:text" or "b:hex") or name (e.g., "/Yes") to a plain string.
+func decodeString(value string) string {
+	switch {
+	case strings.HasPrefix(value, "u:"):
+		return strings.TrimPrefix(value, "u:")
+	case strings.HasPrefix(value, "b:"):
+		data, err := hex.DecodeString(strings.TrimPrefix(value, "b:"))
+		if err != nil {
+			return value
+		}
+		return string(data)
+	case strings.HasPrefix(value, "n:"):
+		return strings.TrimPrefix(value, "n:/")
+	case strings.HasPrefix(value, "/"):
+		return strings.TrimPrefix(value, "/")
+	default:
+		return value
+	}
+}
+
+// isOn tells whether a checkbox value is an "on" state.
+func isOn(value interface{}) bool {
+	state, ok := value.(string)
+	return ok && state != "" && state != "Off"
+}
@@ -0,0 +1,124 @@
+package qpdf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestParseFormFields(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		data         string
+		expectFields []gotenberg.FormField
+		expectError  bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			expectError: true,
+		},
+		{
+			scenario:    "missing objects",
+			data:        `{"acroform":{"fields":[]},"qpdf":[{}]}`,
+			expectError: true,
+		},
+		{
+			scenario:     "no fields",
+			data:         `{"acroform":{"fields":[]},"qpdf":[{},{}]}`,
+			expectFields: []gotenberg.FormField{},
+		},
+		{
+			scenario: "success",
+			data: `{
+				"acroform": {
+					"fields": [
+						{"fullname": "name", "fieldtype": "/Tx", "fieldflags": 2, "value": "u:Jane Doe", "defaultvalue": "u:", "istext": true, "pageposfrom1": 1, "annotation": {"object": "7 0 R"}},
+						{"fullname": "subscribe", "fieldtype": "/Btn", "fieldflags": 0, "value": "/Yes", "defaultvalue": "/Off", "ischeckbox": true, "pageposfrom1": 1, "annotation": {"object": "8 0 R"}},
+						{"fullname": "gender", "fieldtype": "/Btn", "fieldflags": 49152, "value": "/Female", "defaultvalue": null, "isradiobutton": true, "pageposfrom1": 1, "annotation": {"object": "9 0 R"}},
+						{"fullname": "gender", "fieldtype": "/Btn", "fieldflags": 49152, "value": "/Female", "defaultvalue": null, "isradiobutton": true, "pageposfrom1": 1, "annotation": {"object": "10 0 R"}},
+						{"fullname": "colors", "fieldtype": "/Ch", "fieldflags": 2097153, "value": ["u:Red", "u:Blue"], "defaultvalue": null, "choices": ["Red", "Green", "Blue"], "ischoice": true, "pageposfrom1": 2, "annotation": {"object": "11 0 R"}},
+						{"fullname": "submit", "fieldtype": "/Btn", "fieldflags": 65536, "value": null, "defaultvalue": null, "pageposfrom1": 2, "annotation": {"object": "12 0 R"}},
+						{"fullname": "signature", "fieldtype": "/Sig", "fieldflags": 0, "value": null, "defaultvalue": null, "pageposfrom1": 2, "annotation": {"object": "13 0 R"}}
+					]
+				},
+				"qpdf": [
+					{},
+					{
+						"obj:7 0 R": {"value": {"/FT": "/Tx", "/T": "u:name", "/Rect": [150, 490, 400, 515]}},
+						"obj:8 0 R": {"value": {"/FT": "/Btn", "/T": "u:subscribe", "/Rect": "14 0 R", "/AP": {"/N": {"/Yes": "15 0 R", "/Off": "16 0 R"}}}},
+						"obj:9 0 R": {"value": {"/Parent": "17 0 R", "/Rect": [150, 300, 168, 318], "/AP": {"/N": {"/Male": "15 0 R", "/Off": "16 0 R"}}}},
+						"obj:10 0 R": {"value": {"/Parent": "17 0 R", "/Rect": [200, 300, 218, 318], "/AP": {"/N": {"/Female": "15 0 R", "/Off": "16 0 R"}}}},
+						"obj:11 0 R": {"value": {"/FT": "/Ch", "/T": "u:colors", "/Rect": [150, 370, 400, 395]}},
+						"obj:14 0 R": {"value": [150, 455, 168, 473]},
+						"trailer": {"value": {"/Root": "1 0 R"}}
+					}
+				]
+			}`,
+			expectFields: []gotenberg.FormField{
+				{
+					Name:         "name",
+					Type:         gotenberg.FormFieldTypeText,
+					Value:        "Jane Doe",
+					DefaultValue: "",
+					Required:     true,
+					Page:         1,
+					Rect:         [4]float64{150, 490, 400, 515},
+				},
+				{
+					Name:         "subscribe",
+					Type:         gotenberg.FormFieldTypeCheckbox,
+					Value:        true,
+					DefaultValue: false,
+					Options:      []string{"Yes"},
+					Page:         1,
+					Rect:         [4]float64{150, 455, 168, 473},
+				},
+				{
+					Name:    "gender",
+					Type:    gotenberg.FormFieldTypeRadio,
+					Value:   "Female",
+					Options: []string{"Male", "Female"},
+					Page:    1,
+					Rect:    [4]float64{150, 300, 168, 318},
+				},
+				{
+					Name:     "colors",
+					Type:     gotenberg.FormFieldTypeChoice,
+					Value:    []string{"Red", "Blue"},
+					Options:  []string{"Red", "Green", "Blue"},
+					ReadOnly: true,
+					Page:     2,
+					Rect:     [4]float64{150, 370, 400, 395},
+				},
+				{
+					Name: "submit",
+					Type: gotenberg.FormFieldTypeButton,
+					Page: 2,
+				},
+				{
+					Name: "signature",
+					Type: gotenberg.FormFieldTypeSignature,
+					Page: 2,
+				},
+			},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			fields, err := parseFormFields([]byte(tc.data))
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(fields, tc.expectFields) {
+				t.Errorf("expected %+v but got: %+v", tc.expectFields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package qpdf
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// maxInheritanceDepth prevents infinite loops while walking the /Parent
+// entries of malformed PDFs.
+const maxInheritanceDepth = 64
+
+// qpdfJson represents the subset of the QPDF JSON output (version 2) required
+// to build a [gotenberg.PdfInfo].
+type qpdfJson struct {
+	Pages []struct {
+		Object string `json:"object"`
+	} `json:"pages"`
+	Encrypt struct {
+		Encrypted    bool            `json:"encrypted"`
+		Capabilities map[string]bool `json:"capabilities"`
+	} `json:"encrypt"`
+	AcroForm struct {
+		Fields []struct {
+			Object    string `json:"object"`
+			FieldType string `json:"fieldtype"`
+		} `json:"fields"`
+	} `json:"acroform"`
+	Qpdf []json.RawMessage `json:"qpdf"`
+}
+
+// qpdfJsonHeader is the first entry of the "qpdf" key of the QPDF JSON
+// output.
+type qpdfJsonHeader struct {
+	PdfVersion string `json:"pdfversion"`
+}
+
+// qpdfJsonObject is a PDF object of the QPDF JSON output, either a direct
+// value or a stream.
+type qpdfJsonObject struct {
+	Value  interface{} `json:"value"`
+	Stream *struct {
+		Data string                 `json:"data"`
+		Dict map[string]interface{} `json:"dict"`
+	} `json:"stream"`
+}
+
+// qpdfObjects indexes the PDF objects of the QPDF JSON output by their keys
+// (e.g., "obj:3 0 R" or "trailer").
+type qpdfObjects map[string]qpdfJsonObject
+
+var referenceRegexp = regexp.MustCompile(`^\d+ \d+ R$`)
+
+// resolve returns the direct value of a reference, or the value itself. The
+// dictionary of a stream is returned in place of the stream.
+func (objects qpdfObjects) resolve(value interface{}) interface{} {
+	ref, ok := value.(string)
+	if !ok || !referenceRegexp.MatchString(ref) {
+		return value
+	}
+
+	object, ok := objects["obj:"+ref]
+	if !ok {
+		return nil
+	}
+
+	if object.Stream != nil {
+		return object.Stream.Dict
+	}
+
+	return object.Value
+}
+
+// dict returns the dictionary behind a value, or nil.
+func (objects qpdfObjects) dict(value interface{}) map[string]interface{} {
+	dict, ok := objects.resolve(value).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return dict
+}
+
+// inherited returns the value of a key from a dictionary or, if missing, from
+// its ancestors.
+func (objects qpdfObjects) inherited(dict map[string]interface{}, key string) interface{} {
+	for i := 0; dict != nil && i < maxInheritanceDepth; i++ {
+		if value, ok := dict[key]; ok {
+			return objects.resolve(value)
+		}
+
+		dict = objects.dict(dict["/Parent"])
+	}
+
+	return nil
+}
+
+// rectangle converts a PDF array to a box.
+func (objects qpdfObjects) rectangle(value interface{}) ([4]float64, bool) {
+	var box [4]float64
+
+	array, ok := value.([]interface{})
+	if !ok || len(array) != 4 {
+		return box, false
+	}
+
+	for i, item := range array {
+		number, ok := objects.resolve(item).(float64)
+		if !ok {
+			return box, false
+		}
+		box[i] = number
+	}
+
+	return box, true
+}
+
+// parseInfo builds a [gotenberg.PdfInfo] from the QPDF JSON output. It also
+// returns the reference of the XMP metadata stream of the document catalog,
+// if any.
+func parseInfo(data []byte) (gotenberg.PdfInfo, string, error) {
+	var (
+		info   gotenberg.PdfInfo
+		output qpdfJson
+	)
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return info, "", fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	if len(output.Qpdf) != 2 {
+		return info, "", fmt.Errorf("expected 2 entries for the 'qpdf' key, got %d", len(output.Qpdf))
+	}
+
+	var header qpdfJsonHeader
+	err = json.Unmarshal(output.Qpdf[0], &header)
+	if err != nil {
+		return info, "", fmt.Errorf("unmarshal QPDF JSON header: %w", err)
+	}
+
+	var objects qpdfObjects
+	err = json.Unmarshal(output.Qpdf[1], &objects)
+	if err != nil {
+		return info, "", fmt.Errorf("unmarshal QPDF JSON objects: %w", err)
+	}
+
+	info.Version = header.PdfVersion
+	info.PageCount = len(output.Pages)
+	info.Pages = make([]gotenberg.PdfPageInfo, len(output.Pages))
+
+	for i, page := range output.Pages {
+		dict := objects.dict(page.Object)
+		if dict == nil {
+			return info, "", fmt.Errorf("page %d object '%s' not found", i+1, page.Object)
+		}
+
+		mediaBox, ok := objects.rectangle(objects.inherited(dict, "/MediaBox"))
+		if !ok {
+			// Per the specification, assume a US Letter page.
+			mediaBox = [4]float64{0, 0, 612, 792}
+		}
+
+		cropBox, ok := objects.rectangle(objects.inherited(dict, "/CropBox"))
+		if !ok {
+			cropBox = mediaBox
+		}
+
+		var rotation int
+		if rotate, ok := objects.inherited(dict, "/Rotate").(float64); ok {
+			rotation = (int(rotate)%360 + 360) % 360
+		}
+
+		info.Pages[i] = gotenberg.PdfPageInfo{
+			Number:   i + 1,
+			MediaBox: mediaBox,
+			CropBox:  cropBox,
+			Width:    math.Abs(cropBox[2] - cropBox[0]),
+			Height:   math.Abs(cropBox[3] - cropBox[1]),
+			Rotation: rotation,
+		}
+	}
+
+	for _, object := range objects {
+		dict, ok := object.Value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if _, ok := dict["/Linearized"]; ok {
+			info.Linearized = true
+			break
+		}
+	}
+
+	capabilities := output.Encrypt.Capabilities
+	info.Encrypted = output.Encrypt.Encrypted
+	info.Permissions = gotenberg.PdfPermissions{
+		Print:            capabilities["printlow"],
+		PrintHighQuality: capabilities["printhigh"],
+		Modify:           capabilities["modifyother"],
+		Copy:             capabilities["extract"],
+		Annotate:         capabilities["modifyannotations"],
+		FillForms:        capabilities["modifyforms"],
+		Assemble:         capabilities["modifyassembly"],
+		Accessibility:    capabilities["accessibility"],
+	}
+
+	for _, field := range output.AcroForm.Fields {
+		if field.FieldType != "/Sig" {
+			info.HasForm = true
+			continue
+		}
+
+		if objects.inherited(objects.dict(field.Object), "/V") != nil {
+			info.HasSignatures = true
+		}
+	}
+
+	var metadataRef string
+	trailer := objects.dict(objects["trailer"].Value)
+	if trailer != nil {
+		catalog := objects.dict(trailer["/Root"])
+		if catalog != nil {
+			ref, ok := catalog["/Metadata"].(string)
+			if ok && referenceRegexp.MatchString(ref) {
+				metadataRef = ref
+			}
+		}
+	}
+
+	return info, metadataRef, nil
+}
+
+// parseStreamData returns the decoded data of a stream from the QPDF JSON
+// output, generated with inline stream data.
+func parseStreamData(data []byte, ref string) ([]byte, error) {
+	var output qpdfJson
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	if len(output.Qpdf) != 2 {
+		return nil, fmt.Errorf("expected 2 entries for the 'qpdf' key, got %d", len(output.Qpdf))
+	}
+
+	var objects qpdfObjects
+	err = json.Unmarshal(output.Qpdf[1], &objects)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON objects: %w", err)
+	}
+
+	object, ok := objects["obj:"+ref]
+	if !ok || object.Stream == nil {
+		return nil, fmt.Errorf("stream '%s' not found", ref)
+	}
+
+	streamData, err := base64.StdEncoding.DecodeString(object.Stream.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode stream '%s' data: %w", ref, err)
+	}
+
+	return streamData, nil
+}
+
+var (
+	pdfAPartRegexp        = regexp.MustCompile(`pdfaid:part(?:="|>)\s*(\d)`)
+	pdfAConformanceRegexp = regexp.MustCompile(`pdfaid:conformance(?:="|>)\s*([A-Za-z])`)
+	pdfUaPartRegexp       = regexp.MustCompile(`pdfuaid:part(?:="|>)\s*(\d)`)
+)
+
+// parseXmpClaims returns the PDF/A format (e.g., "PDF/A-2b") and the PDF/UA
+// compliance claimed by XMP metadata. Both the attribute and the element
+// forms of the identification schemas are supported.
+func parseXmpClaims(xmp []byte) (string, bool) {
+	var pdfA string
+
+	part := pdfAPartRegexp.FindSubmatch(xmp)
+	if part != nil {
+		pdfA = fmt.Sprintf("PDF/A-%s", part[1])
+
+		conformance := pdfAConformanceRegexp.FindSubmatch(xmp)
+		if conformance != nil {
+			pdfA += strings.ToLower(string(conformance[1]))
+		}
+	}
+
+	return pdfA, pdfUaPartRegexp.Match(xmp)
+}
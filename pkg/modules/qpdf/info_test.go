@@ -0,0 +1,232 @@
+package qpdf
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestParseInfo(t *testing.T) {
+	for _, tc := range []struct {
+		scenario          string
+		data              string
+		expectInfo        gotenberg.PdfInfo
+		expectMetadataRef string
+		expectError       bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			expectError: true,
+		},
+		{
+			scenario:    "missing objects",
+			data:        `{"pages":[],"qpdf":[{"pdfversion":"1.7"}]}`,
+			expectError: true,
+		},
+		{
+			scenario: "page object not found",
+			data: `{
+				"pages": [{"object": "3 0 R"}],
+				"qpdf": [{"pdfversion": "1.7"}, {"trailer": {"value": {}}}]
+			}`,
+			expectError: true,
+		},
+		{
+			scenario: "success",
+			data: `{
+				"pages": [{"object": "3 0 R"}, {"object": "4 0 R"}],
+				"encrypt": {
+					"encrypted": true,
+					"capabilities": {
+						"accessibility": true,
+						"extract": false,
+						"printlow": true,
+						"printhigh": false,
+						"modifyassembly": false,
+						"modifyforms": true,
+						"modifyannotations": true,
+						"modifyother": false
+					}
+				},
+				"acroform": {
+					"fields": [
+						{"object": "7 0 R", "fieldtype": "/Tx"},
+						{"object": "8 0 R", "fieldtype": "/Sig"},
+						{"object": "9 0 R", "fieldtype": "/Sig"}
+					]
+				},
+				"qpdf": [
+					{"pdfversion": "1.6"},
+					{
+						"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/Metadata": "6 0 R"}},
+						"obj:2 0 R": {"value": {"/Type": "/Pages", "/MediaBox": [0, 0, 595.28, 841.89], "/Rotate": 90}},
+						"obj:3 0 R": {"value": {"/Type": "/Page", "/Parent": "2 0 R"}},
+						"obj:4 0 R": {"value": {"/Type": "/Page", "/Parent": "2 0 R", "/MediaBox": "5 0 R", "/CropBox": [10, 10, 110, 210], "/Rotate": -90}},
+						"obj:5 0 R": {"value": [0, 0, 612, 792]},
+						"obj:6 0 R": {"stream": {"dict": {"/Type": "/Metadata", "/Subtype": "/XML"}}},
+						"obj:7 0 R": {"value": {"/FT": "/Tx", "/T": "u:name"}},
+						"obj:8 0 R": {"value": {"/FT": "/Sig", "/T": "u:unsigned"}},
+						"obj:9 0 R": {"value": {"/Parent": "10 0 R"}},
+						"obj:10 0 R": {"value": {"/FT": "/Sig", "/T": "u:signed", "/V": "11 0 R"}},
+						"obj:11 0 R": {"value": {"/Type": "/Sig"}},
+						"obj:12 0 R": {"value": {"/Linearized": 1}},
+						"trailer": {"value": {"/Root": "1 0 R", "/Size": 13}}
+					}
+				]
+			}`,
+			expectInfo: gotenberg.PdfInfo{
+				Version:   "1.6",
+				PageCount: 2,
+				Pages: []gotenberg.PdfPageInfo{
+					{
+						Number:   1,
+						MediaBox: [4]float64{0, 0, 595.28, 841.89},
+						CropBox:  [4]float64{0, 0, 595.28, 841.89},
+						Width:    595.28,
+						Height:   841.89,
+						Rotation: 90,
+					},
+					{
+						Number:   2,
+						MediaBox: [4]float64{0, 0, 612, 792},
+						CropBox:  [4]float64{10, 10, 110, 210},
+						Width:    100,
+						Height:   200,
+						Rotation: 270,
+					},
+				},
+				Linearized: true,
+				Encrypted:  true,
+				Permissions: gotenberg.PdfPermissions{
+					Print:         true,
+					Annotate:      true,
+					FillForms:     true,
+					Accessibility: true,
+				},
+				HasForm:       true,
+				HasSignatures: true,
+			},
+			expectMetadataRef: "6 0 R",
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			info, metadataRef, err := parseInfo([]byte(tc.data))
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if tc.expectError {
+				return
+			}
+
+			if !reflect.DeepEqual(info, tc.expectInfo) {
+				t.Errorf("expected %+v but got: %+v", tc.expectInfo, info)
+			}
+
+			if metadataRef != tc.expectMetadataRef {
+				t.Errorf("expected metadata reference '%s' but got '%s'", tc.expectMetadataRef, metadataRef)
+			}
+		})
+	}
+}
+
+func TestParseStreamData(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		data        string
+		ref         string
+		expectData  string
+		expectError bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			ref:         "6 0 R",
+			expectError: true,
+		},
+		{
+			scenario:    "stream not found",
+			data:        `{"qpdf":[{},{"obj:6 0 R":{"value":{}}}]}`,
+			ref:         "6 0 R",
+			expectError: true,
+		},
+		{
+			scenario:    "invalid base64 data",
+			data:        `{"qpdf":[{},{"obj:6 0 R":{"stream":{"data":"!","dict":{}}}}]}`,
+			ref:         "6 0 R",
+			expectError: true,
+		},
+		{
+			scenario:   "success",
+			data:       `{"qpdf":[{},{"obj:6 0 R":{"stream":{"data":"` + base64.StdEncoding.EncodeToString([]byte("<x:xmpmeta/>")) + `","dict":{}}}}]}`,
+			ref:        "6 0 R",
+			expectData: "<x:xmpmeta/>",
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			data, err := parseStreamData([]byte(tc.data), tc.ref)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if string(data) != tc.expectData {
+				t.Errorf("expected data '%s' but got '%s'", tc.expectData, string(data))
+			}
+		})
+	}
+}
+
+func TestParseXmpClaims(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		xmp         string
+		expectPdfA  string
+		expectPdfUa bool
+	}{
+		{
+			scenario: "no claims",
+			xmp:      `<rdf:Description rdf:about="" xmlns:dc="http://purl.org/dc/elements/1.1/"/>`,
+		},
+		{
+			scenario:   "PDF/A attributes",
+			xmp:        `<rdf:Description rdf:about="" pdfaid:part="2" pdfaid:conformance="B"/>`,
+			expectPdfA: "PDF/A-2b",
+		},
+		{
+			scenario:    "PDF/A and PDF/UA elements",
+			xmp:         `<pdfaid:part>3</pdfaid:part><pdfaid:conformance>U</pdfaid:conformance><pdfuaid:part>1</pdfuaid:part>`,
+			expectPdfA:  "PDF/A-3u",
+			expectPdfUa: true,
+		},
+		{
+			scenario:   "PDF/A without conformance",
+			xmp:        `<rdf:Description pdfaid:part="4"/>`,
+			expectPdfA: "PDF/A-4",
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			pdfA, pdfUa := parseXmpClaims([]byte(tc.xmp))
+
+			if pdfA != tc.expectPdfA {
+				t.Errorf("expected PDF/A '%s' but got '%s'", tc.expectPdfA, pdfA)
+			}
+
+			if pdfUa != tc.expectPdfUa {
+				t.Errorf("expected PDF/UA %t but got %t", tc.expectPdfUa, pdfUa)
+			}
+		})
+	}
+}
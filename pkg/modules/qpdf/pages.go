@@ -0,0 +1,164 @@
+package qpdf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// selectPages returns, for each page of a document with the given page count,
+// whether the selection (e.g., "1-3, 5, 8-") contains it. The slice is
+// indexed from 1.
+func selectPages(selection string, pageCount int) ([]bool, error) {
+	ranges, err := gotenberg.ParsePageRanges(selection)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]bool, pageCount+1)
+
+	for _, pageRange := range ranges {
+		last := pageRange.Last
+		if last == 0 {
+			last = pageCount
+		}
+
+		if pageRange.First > pageCount || last > pageCount {
+			return nil, fmt.Errorf("page range '%s' exceeds page count %d", formatPageRange(pageRange), pageCount)
+		}
+
+		for page := pageRange.First; page <= last; page++ {
+			selected[page] = true
+		}
+	}
+
+	return selected, nil
+}
+
+// pageRanges translates a selection of pages (see
+// [gotenberg.ParsePageRanges]) to the QPDF page ranges syntax, where "z" is
+// the last page.
+func pageRanges(selection string) (string, error) {
+	ranges, err := gotenberg.ParsePageRanges(selection)
+	if err != nil {
+		return "", err
+	}
+
+	values := make([]string, len(ranges))
+	for i, pageRange := range ranges {
+		values[i] = formatPageRange(pageRange)
+	}
+
+	return strings.Join(values, ","), nil
+}
+
+// formatPageRange formats a page range with the QPDF syntax.
+func formatPageRange(pageRange gotenberg.PageRange) string {
+	switch pageRange.Last {
+	case pageRange.First:
+		return strconv.Itoa(pageRange.First)
+	case 0:
+		return fmt.Sprintf("%d-z", pageRange.First)
+	default:
+		return fmt.Sprintf("%d-%d", pageRange.First, pageRange.Last)
+	}
+}
+
+// editPages applies the instructions to the pages of a document with the
+// given page count. It returns the resulting sequence of the original page
+// numbers, suitable for the --pages option.
+func editPages(instructions []gotenberg.PageEditInstruction, pageCount int) ([]int, error) {
+	pages := make([]int, pageCount)
+	for i := range pages {
+		pages[i] = i + 1
+	}
+
+	for _, instruction := range instructions {
+		if instruction.Op == gotenberg.PageEditInsertBlank {
+			return nil, errors.New("inserting blank pages is not supported")
+		}
+
+		selected, err := selectPages(instruction.Pages, len(pages))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", instruction.Op, err)
+		}
+
+		count := instruction.Count
+		if count == 0 {
+			count = 1
+		}
+
+		var (
+			edited []int
+			moved  []int
+		)
+
+		switch instruction.Op {
+		case gotenberg.PageEditKeep:
+			for i, page := range pages {
+				if selected[i+1] {
+					edited = append(edited, page)
+				}
+			}
+		case gotenberg.PageEditDelete:
+			for i, page := range pages {
+				if !selected[i+1] {
+					edited = append(edited, page)
+				}
+			}
+		case gotenberg.PageEditMove:
+			if instruction.After < 0 || instruction.After > len(pages) {
+				return nil, fmt.Errorf("%s: page %d does not exist", instruction.Op, instruction.After)
+			}
+
+			if selected[instruction.After] {
+				return nil, fmt.Errorf("%s: cannot move pages after page %d, as it is moved too", instruction.Op, instruction.After)
+			}
+
+			for i, page := range pages {
+				if selected[i+1] {
+					moved = append(moved, page)
+				}
+			}
+
+			if instruction.After == 0 {
+				edited = append(edited, moved...)
+			}
+
+			for i, page := range pages {
+				if selected[i+1] {
+					continue
+				}
+
+				edited = append(edited, page)
+				if i+1 == instruction.After {
+					edited = append(edited, moved...)
+				}
+			}
+		case gotenberg.PageEditDuplicate:
+			for i, page := range pages {
+				edited = append(edited, page)
+				if !selected[i+1] {
+					continue
+				}
+
+				for j := 0; j < count; j++ {
+					edited = append(edited, page)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("operation '%s' is not supported", instruction.Op)
+		}
+
+		if len(edited) == 0 {
+			return nil, fmt.Errorf("%s: no pages left", instruction.Op)
+		}
+
+		pages = edited
+	}
+
+	return pages, nil
+}
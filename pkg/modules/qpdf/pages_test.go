@@ -0,0 +1,190 @@
+package qpdf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestEditPages(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		instructions []gotenberg.PageEditInstruction
+		pageCount    int
+		expectPages  []int
+		expectError  bool
+	}{
+		{
+			scenario:    "no instructions",
+			pageCount:   3,
+			expectPages: []int{1, 2, 3},
+		},
+		{
+			scenario: "keep",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditKeep, Pages: "1, 4-"},
+			},
+			pageCount:   5,
+			expectPages: []int{1, 4, 5},
+		},
+		{
+			scenario: "delete",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditDelete, Pages: "3-5"},
+			},
+			pageCount:   6,
+			expectPages: []int{1, 2, 6},
+		},
+		{
+			scenario: "move to the beginning",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditMove, Pages: "3-4"},
+			},
+			pageCount:   4,
+			expectPages: []int{3, 4, 1, 2},
+		},
+		{
+			scenario: "move after a page",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditMove, Pages: "1", After: 3},
+			},
+			pageCount:   4,
+			expectPages: []int{2, 3, 1, 4},
+		},
+		{
+			scenario: "duplicate",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditDuplicate, Pages: "2", Count: 2},
+			},
+			pageCount:   3,
+			expectPages: []int{1, 2, 2, 2, 3},
+		},
+		{
+			scenario: "instructions in order",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditDelete, Pages: "1"},
+				{Op: gotenberg.PageEditDuplicate, Pages: "1"},
+				{Op: gotenberg.PageEditMove, Pages: "3", After: 1},
+			},
+			pageCount:   3,
+			expectPages: []int{2, 3, 2},
+		},
+		{
+			scenario: "insert blank pages",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditInsertBlank, After: 1},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "missing pages",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditDelete},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "invalid page range",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditKeep, Pages: "3-1"},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "page range exceeding page count",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditKeep, Pages: "2-4"},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "move after a moved page",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditMove, Pages: "1-2", After: 2},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "move after a non-existing page",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditMove, Pages: "1", After: 4},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "no pages left",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: gotenberg.PageEditDelete, Pages: "1-"},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+		{
+			scenario: "unsupported operation",
+			instructions: []gotenberg.PageEditInstruction{
+				{Op: "foo", Pages: "1"},
+			},
+			pageCount:   3,
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			pages, err := editPages(tc.instructions, tc.pageCount)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(pages, tc.expectPages) {
+				t.Errorf("expected %v but got: %v", tc.expectPages, pages)
+			}
+		})
+	}
+}
+
+func TestPageRanges(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		selection    string
+		expectRanges string
+		expectError  bool
+	}{
+		{
+			scenario:     "ranges",
+			selection:    "1-3, 5, 8-",
+			expectRanges: "1-3,5,8-z",
+		},
+		{
+			scenario:    "QPDF syntax",
+			selection:   "r1",
+			expectError: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			ranges, err := pageRanges(tc.selection)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if ranges != tc.expectRanges {
+				t.Errorf("expected '%s' but got: '%s'", tc.expectRanges, ranges)
+			}
+		})
+	}
+}
@@ -3,11 +3,14 @@ package qpdf
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"go.uber.org/zap"
@@ -172,21 +175,95 @@ func (engine *QPdf) WriteMetadata(ctx context.Context, logger *zap.Logger, metad
 	return fmt.Errorf("write PDF metadata with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
-// Encrypt adds password protection to a PDF file using QPDF.
-func (engine *QPdf) Encrypt(ctx context.Context, logger *zap.Logger, inputPath, userPassword, ownerPassword string) error {
-	if userPassword == "" {
+// Encrypt adds password protection to a PDF file using QPDF. It defaults to
+// the AES 256-bit encryption.
+func (engine *QPdf) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	if options.UserPassword == "" {
 		return errors.New("user password cannot be empty")
 	}
 
+	ownerPassword := options.OwnerPassword
 	if ownerPassword == "" {
-		ownerPassword = userPassword
+		ownerPassword = options.UserPassword
+	}
+
+	yesNo := func(allow bool) string {
+		if allow {
+			return "y"
+		}
+		return "n"
+	}
+
+	// QPDF allows everything by default.
+	permissions := gotenberg.PdfPermissions{
+		Print:            true,
+		PrintHighQuality: true,
+		Modify:           true,
+		Copy:             true,
+		Annotate:         true,
+		FillForms:        true,
+		Assemble:         true,
+		Accessibility:    true,
+	}
+	if options.Permissions != nil {
+		permissions = *options.Permissions
+	}
+
+	var (
+		encryptArgs     []string
+		allowWeakCrypto bool
+	)
+	switch options.Algorithm {
+	case "", gotenberg.EncryptionAlgorithmAes256:
+		encryptArgs = append(encryptArgs, "256")
+	case gotenberg.EncryptionAlgorithmAes128:
+		encryptArgs = append(encryptArgs, "128", "--use-aes=y")
+	case gotenberg.EncryptionAlgorithmRc4128:
+		encryptArgs = append(encryptArgs, "128")
+		allowWeakCrypto = true
+	case gotenberg.EncryptionAlgorithmRc440:
+		if !permissions.FillForms || !permissions.Assemble || !permissions.Accessibility || (permissions.Print && !permissions.PrintHighQuality) {
+			return gotenberg.NewPdfEngineInvalidArgs("qpdf", fmt.Sprintf("the '%s' algorithm only restricts the print, modify, copy and annotate permissions", options.Algorithm))
+		}
+
+		encryptArgs = append(encryptArgs, "40")
+		allowWeakCrypto = true
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--print=%s", yesNo(permissions.Print)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--modify=%s", yesNo(permissions.Modify)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--extract=%s", yesNo(permissions.Copy)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--annotate=%s", yesNo(permissions.Annotate)))
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("qpdf", fmt.Sprintf("encryption algorithm '%s' is not supported", options.Algorithm))
+	}
+
+	if options.Algorithm != gotenberg.EncryptionAlgorithmRc440 {
+		printLevel := "none"
+		if permissions.Print && permissions.PrintHighQuality {
+			printLevel = "full"
+		} else if permissions.Print {
+			printLevel = "low"
+		}
+
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--print=%s", printLevel))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--modify-other=%s", yesNo(permissions.Modify)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--extract=%s", yesNo(permissions.Copy)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--annotate=%s", yesNo(permissions.Annotate)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--form=%s", yesNo(permissions.FillForms)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--assemble=%s", yesNo(permissions.Assemble)))
+		encryptArgs = append(encryptArgs, fmt.Sprintf("--accessibility=%s", yesNo(permissions.Accessibility)))
 	}
 
 	var args []string
 	args = append(args, inputPath)
 	args = append(args, engine.globalArgs...)
 	args = append(args, "--replace-input")
-	args = append(args, "--encrypt", userPassword, ownerPassword, "256", "--")
+	if allowWeakCrypto {
+		// A main option, which does not belong to the encryption options.
+		args = append(args, "--allow-weak-crypto")
+	}
+	args = append(args, "--encrypt", options.UserPassword, ownerPassword)
+	args = append(args, encryptArgs...)
+	args = append(args, "--")
 
 	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
 	if err != nil {
@@ -206,6 +283,610 @@ func (engine *QPdf) EmbedFiles(ctx context.Context, logger *zap.Logger, filePath
 	return fmt.Errorf("embed files with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
 }
 
+// Rotate rotates the pages of a PDF file clockwise by the given angle.
+func (engine *QPdf) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	rotate := fmt.Sprintf("--rotate=+%d", (angle%360+360)%360)
+	if pages != "" {
+		ranges, err := pageRanges(pages)
+		if err != nil {
+			return gotenberg.NewPdfEngineInvalidArgs("qpdf", err.Error())
+		}
+
+		rotate = fmt.Sprintf("%s:%s", rotate, ranges)
+	}
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--replace-input")
+	args = append(args, rotate)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("rotate PDF with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// Watermark is not available in this implementation.
+func (engine *QPdf) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay lays the pages of another PDF over or under the pages of a PDF
+// file.
+func (engine *QPdf) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--replace-input")
+
+	if overlay.Underlay {
+		args = append(args, "--underlay", overlay.Path)
+	} else {
+		args = append(args, "--overlay", overlay.Path)
+	}
+
+	switch overlay.Mode {
+	case gotenberg.OverlayModeFirst:
+		args = append(args, "--to=1", "--from=1")
+	case gotenberg.OverlayModeSequence:
+		args = append(args, "--to=1-z", "--from=1-z")
+	case gotenberg.OverlayModeRepeat:
+		args = append(args, "--to=1-z", "--from=1-z", "--repeat=z")
+	default:
+		return gotenberg.NewPdfEngineInvalidArgs("qpdf", fmt.Sprintf("overlay mode '%s' is not supported", overlay.Mode))
+	}
+
+	args = append(args, "--")
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("overlay PDF with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// Info retrieves information about a given PDF file, thanks to the JSON
+// representation of QPDF. The PDF/A and PDF/UA claims come from the XMP
+// metadata of the document catalog.
+func (engine *QPdf) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=pages", "--json-key=encrypt", "--json-key=acroform", "--json-key=qpdf")
+	args = append(args, jsonPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with QPDF: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	info, metadataRef, err := parseInfo(data)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("parse QPDF JSON: %w", err)
+	}
+
+	if metadataRef == "" {
+		return info, nil
+	}
+
+	// Object "3 0 R" is selected with "3,0".
+	objectId := strings.Replace(strings.TrimSuffix(metadataRef, " R"), " ", ",", 1)
+
+	args = nil
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=qpdf", fmt.Sprintf("--json-object=%s", objectId))
+	args = append(args, "--json-stream-data=inline")
+	args = append(args, jsonPath)
+
+	cmd, err = gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("get PDF XMP metadata with QPDF: %w", err)
+	}
+
+	data, err = os.ReadFile(jsonPath)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	xmp, err := parseStreamData(data, metadataRef)
+	if err != nil {
+		return gotenberg.PdfInfo{}, fmt.Errorf("parse QPDF JSON: %w", err)
+	}
+
+	info.PdfA, info.PdfUa = parseXmpClaims(xmp)
+
+	return info, nil
+}
+
+// Optimize reduces the size of a PDF file. Removing duplicate resources is not
+// available in this implementation.
+func (engine *QPdf) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	if options.RemoveDuplicates {
+		return gotenberg.NewPdfEngineInvalidArgs("qpdf", "removing duplicate resources is not supported")
+	}
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--replace-input")
+
+	if options.CompressStreams {
+		args = append(args, "--compress-streams=y", "--recompress-flate", "--compression-level=9")
+	}
+
+	if options.ObjectStreams {
+		args = append(args, "--object-streams=generate")
+	}
+
+	if options.CompressImages {
+		args = append(args, "--optimize-images")
+	}
+
+	if options.Linearize {
+		args = append(args, "--linearize")
+	}
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("optimize PDF with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// Decrypt removes the password protection of a PDF file. It does nothing if
+// the PDF is not encrypted.
+func (engine *QPdf) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	// QPDF reads the password from a file, so that it does not show in the
+	// command line.
+	passPath := inputPath + ".pass"
+	err := os.WriteFile(passPath, []byte(password), 0o600)
+	if err != nil {
+		return fmt.Errorf("write password file: %w", err)
+	}
+	defer func() {
+		err := os.Remove(passPath)
+		if err != nil {
+			logger.Error(fmt.Sprintf("remove password file: %s", err))
+		}
+	}()
+
+	passwordFile := fmt.Sprintf("--password-file=%s", passPath)
+
+	// Exit codes: 0 if the password is wrong, 3 if the password works, and 2
+	// if the PDF is not encrypted or on any other error.
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, inputPath, passwordFile, "--requires-password")
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	exitCode, err := cmd.Exec()
+	switch exitCode {
+	case 0:
+		return fmt.Errorf("decrypt PDF with QPDF: %w", gotenberg.ErrPdfInvalidPassword)
+	case 2:
+		encrypted, err := engine.isEncrypted(ctx, logger, inputPath)
+		if err != nil {
+			return fmt.Errorf("check PDF encryption with QPDF: %w", err)
+		}
+
+		if !encrypted {
+			return nil
+		}
+
+		return errors.New("check PDF password with QPDF: unexpected exit code 2")
+	case 3:
+	default:
+		return fmt.Errorf("check PDF password with QPDF: %w", err)
+	}
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, passwordFile)
+	args = append(args, "--decrypt")
+	args = append(args, "--replace-input")
+
+	cmd, err = gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("decrypt PDF with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// isEncrypted tells whether a PDF file is encrypted, thanks to the
+// encryption details QPDF prints. Unlike the exit codes of the
+// --requires-password option, it does not mistake a damaged PDF for a PDF
+// without encryption.
+func (engine *QPdf) isEncrypted(ctx context.Context, logger *zap.Logger, inputPath string) (bool, error) {
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, inputPath, "--show-encryption")
+	if err != nil {
+		return false, fmt.Errorf("create command: %w", err)
+	}
+
+	var stdout bytes.Buffer
+	cmd.SetStdout(&stdout)
+
+	_, err = cmd.Exec()
+	if strings.Contains(stdout.String(), "File is not encrypted") {
+		// Exit code 3 only means QPDF has warnings.
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ReadBookmarks extracts the outline of a PDF file.
+func (engine *QPdf) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=outlines")
+	args = append(args, jsonPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("read PDF bookmarks with QPDF: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	bookmarks, err := parseBookmarks(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse QPDF JSON: %w", err)
+	}
+
+	return bookmarks, nil
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *QPdf) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *QPdf) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields lists the AcroForm fields of a PDF file.
+func (engine *QPdf) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=acroform", "--json-key=qpdf")
+	args = append(args, jsonPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return nil, fmt.Errorf("read PDF form fields with QPDF: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return nil, fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	fields, err := parseFormFields(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse QPDF JSON: %w", err)
+	}
+
+	return fields, nil
+}
+
+// ExtractText is not available in this implementation.
+func (engine *QPdf) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *QPdf) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *QPdf) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *QPdf) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize removes the selected categories of active or hidden content from
+// a PDF file. It reads the PDF objects as JSON, removes the references to the
+// content, and updates the PDF from the resulting JSON.
+func (engine *QPdf) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=qpdf")
+	args = append(args, jsonPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("read PDF objects with QPDF: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	update, err := sanitizeObjects(data, options)
+	if err != nil {
+		return fmt.Errorf("sanitize QPDF JSON: %w", err)
+	}
+
+	if update == nil {
+		logger.Debug("nothing to sanitize")
+		return nil
+	}
+
+	updatePath := inputPath + ".update.json"
+	defer func() {
+		err := os.Remove(updatePath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON update file: %s", err))
+		}
+	}()
+
+	err = os.WriteFile(updatePath, update, 0o600)
+	if err != nil {
+		return fmt.Errorf("write QPDF JSON input: %w", err)
+	}
+
+	args = nil
+	args = append(args, inputPath)
+	args = append(args, fmt.Sprintf("--update-from-json=%s", updatePath))
+	args = append(args, "--replace-input")
+	args = append(args, engine.globalArgs...)
+
+	cmd, err = gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("sanitize PDF with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// ImportImages is not available in this implementation.
+func (engine *QPdf) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *QPdf) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *QPdf) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit edits the pages of a PDF file thanks to the --pages option of
+// QPDF. Inserting blank pages is not available in this implementation.
+func (engine *QPdf) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	jsonPath := inputPath + ".json"
+	defer func() {
+		err := os.Remove(jsonPath)
+		if err != nil && !os.IsNotExist(err) {
+			logger.Error(fmt.Sprintf("remove QPDF JSON file: %s", err))
+		}
+	}()
+
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--json=2")
+	args = append(args, "--json-key=pages")
+	args = append(args, jsonPath)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("read PDF pages with QPDF: %w", err)
+	}
+
+	data, err := os.ReadFile(jsonPath)
+	if err != nil {
+		return fmt.Errorf("read QPDF JSON: %w", err)
+	}
+
+	var output qpdfJson
+	err = json.Unmarshal(data, &output)
+	if err != nil {
+		return fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	pages, err := editPages(instructions, len(output.Pages))
+	if err != nil {
+		return gotenberg.NewPdfEngineInvalidArgs("qpdf", err.Error())
+	}
+
+	sequence := make([]string, len(pages))
+	for i, page := range pages {
+		sequence[i] = strconv.Itoa(page)
+	}
+
+	args = nil
+	args = append(args, inputPath)
+	args = append(args, engine.globalArgs...)
+	args = append(args, "--replace-input")
+	args = append(args, "--pages", ".", strings.Join(sequence, ","), "--")
+
+	cmd, err = gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err != nil {
+		return fmt.Errorf("edit PDF pages with QPDF: %w", err)
+	}
+
+	return nil
+}
+
+// Sign is not available in this implementation.
+func (engine *QPdf) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *QPdf) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance is not available in this implementation.
+func (engine *QPdf) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	return nil, fmt.Errorf("validate PDF compliance with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Repair rewrites a PDF file. While reading a damaged PDF file, QPDF
+// rebuilds its cross-reference table and recovers its objects, so the
+// rewritten PDF file is sound.
+func (engine *QPdf) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	var args []string
+	args = append(args, inputPath)
+	args = append(args, "--replace-input")
+	args = append(args, engine.globalArgs...)
+
+	cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+	if err != nil {
+		return fmt.Errorf("create command: %w", err)
+	}
+
+	_, err = cmd.Exec()
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("repair PDF with QPDF: %w", err)
+}
+
+// Resize is not available in this implementation.
+func (engine *QPdf) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *QPdf) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with QPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
 var (
 	_ gotenberg.Module      = (*QPdf)(nil)
 	_ gotenberg.Provisioner = (*QPdf)(nil)
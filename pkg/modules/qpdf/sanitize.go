@@ -0,0 +1,193 @@
+package qpdf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// qpdfJsonUpdate represents a PDF object of the QPDF JSON input, as accepted
+// by the --update-from-json option. The data of a stream is left unchanged
+// when only its dictionary is given.
+type qpdfJsonUpdate struct {
+	Value  interface{} `json:"value,omitempty"`
+	Stream *struct {
+		Dict map[string]interface{} `json:"dict"`
+	} `json:"stream,omitempty"`
+}
+
+// sanitizer removes the selected categories of content from the PDF objects
+// of the QPDF JSON output.
+type sanitizer struct {
+	options gotenberg.SanitizeOptions
+	objects map[string]qpdfJsonUpdate
+}
+
+// removedKeys returns the dictionary keys to remove.
+func (s sanitizer) removedKeys() []string {
+	var keys []string
+
+	if s.options.JavaScript {
+		// Additional actions, and the JavaScript entry of the name
+		// dictionary.
+		keys = append(keys, "/AA", "/JavaScript")
+	}
+
+	if s.options.EmbeddedFiles {
+		keys = append(keys, "/EmbeddedFiles", "/AF", "/Collection")
+	}
+
+	if s.options.Xfa {
+		keys = append(keys, "/XFA", "/NeedsRendering")
+	}
+
+	if s.options.Annotations {
+		keys = append(keys, "/Annots", "/AcroForm")
+	}
+
+	return keys
+}
+
+// isRemovedAction tells if a value is an action to remove.
+func (s sanitizer) isRemovedAction(value interface{}) bool {
+	if ref, ok := value.(string); ok && referenceRegexp.MatchString(ref) {
+		value = s.objects["obj:"+ref].Value
+	}
+
+	dict, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	switch dict["/S"] {
+	case "/JavaScript":
+		return s.options.JavaScript
+	case "/Launch":
+		return s.options.LaunchActions
+	default:
+		return false
+	}
+}
+
+// sanitize removes, in place, the content to remove from a value. It tells
+// whether the value has changed.
+func (s sanitizer) sanitize(value interface{}) bool {
+	var changed bool
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, key := range s.removedKeys() {
+			if _, ok := v[key]; ok {
+				delete(v, key)
+				changed = true
+			}
+		}
+
+		for _, key := range []string{"/A", "/OpenAction", "/Next"} {
+			if s.isRemovedAction(v[key]) {
+				delete(v, key)
+				changed = true
+			}
+		}
+
+		// The /Next entry of an action may also be an array of actions.
+		if next, ok := v["/Next"].([]interface{}); ok {
+			var actions []interface{}
+			for _, action := range next {
+				if !s.isRemovedAction(action) {
+					actions = append(actions, action)
+				}
+			}
+
+			if len(actions) != len(next) {
+				v["/Next"] = actions
+				changed = true
+			}
+		}
+
+		// File attachment annotations without their file specification.
+		if s.options.EmbeddedFiles && v["/Subtype"] == "/FileAttachment" {
+			if _, ok := v["/FS"]; ok {
+				delete(v, "/FS")
+				changed = true
+			}
+		}
+
+		for _, item := range v {
+			if s.sanitize(item) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if s.sanitize(item) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// sanitizeObjects removes the selected categories of content from the PDF
+// objects of the QPDF JSON output. It returns the QPDF JSON input with the
+// updated objects only, or nil if there is nothing to update. The removed
+// content becomes unreferenced, so that QPDF drops it while writing the PDF.
+func sanitizeObjects(data []byte, options gotenberg.SanitizeOptions) ([]byte, error) {
+	var output struct {
+		Qpdf []json.RawMessage `json:"qpdf"`
+	}
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON: %w", err)
+	}
+
+	if len(output.Qpdf) != 2 {
+		return nil, fmt.Errorf("expected 2 entries for the 'qpdf' key, got %d", len(output.Qpdf))
+	}
+
+	// Keep the numbers as they are, as QPDF distinguishes integers from
+	// reals.
+	decoder := json.NewDecoder(bytes.NewReader(output.Qpdf[1]))
+	decoder.UseNumber()
+
+	s := sanitizer{options: options}
+	err = decoder.Decode(&s.objects)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal QPDF JSON objects: %w", err)
+	}
+
+	updates := make(map[string]qpdfJsonUpdate)
+	for key, object := range s.objects {
+		if key == "trailer" {
+			continue
+		}
+
+		if object.Stream != nil {
+			if s.sanitize(object.Stream.Dict) {
+				updates[key] = object
+			}
+			continue
+		}
+
+		if s.sanitize(object.Value) {
+			updates[key] = object
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	input, err := json.Marshal(map[string]interface{}{
+		"qpdf": []interface{}{output.Qpdf[0], updates},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal QPDF JSON input: %w", err)
+	}
+
+	return input, nil
+}
@@ -0,0 +1,153 @@
+package qpdf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestSanitizeObjects(t *testing.T) {
+	data := `{
+		"qpdf": [
+			{"jsonversion": 2, "maxobjectid": 10},
+			{
+				"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/OpenAction": "5 0 R", "/Names": {"/JavaScript": "6 0 R", "/EmbeddedFiles": "7 0 R"}, "/AcroForm": {"/Fields": [], "/XFA": "8 0 R"}}},
+				"obj:2 0 R": {"value": {"/Type": "/Pages", "/Kids": ["3 0 R"], "/Count": 1}},
+				"obj:3 0 R": {"value": {"/Type": "/Page", "/Parent": "2 0 R", "/MediaBox": [0, 0, 595.0, 842], "/AA": {"/O": "5 0 R"}, "/Annots": ["4 0 R", "9 0 R"]}},
+				"obj:4 0 R": {"value": {"/Type": "/Annot", "/Subtype": "/Link", "/A": {"/S": "/Launch", "/F": "u:calc.exe", "/Next": ["5 0 R", "10 0 R"]}}},
+				"obj:5 0 R": {"value": {"/S": "/JavaScript", "/JS": "u:app.alert(1)"}},
+				"obj:6 0 R": {"value": {"/Names": ["u:script", "5 0 R"]}},
+				"obj:7 0 R": {"value": {"/Names": []}},
+				"obj:8 0 R": {"stream": {"dict": {"/AF": []}}},
+				"obj:9 0 R": {"value": {"/Type": "/Annot", "/Subtype": "/FileAttachment", "/FS": "u:file.xml"}},
+				"obj:10 0 R": {"value": {"/S": "/URI", "/URI": "u:https://gotenberg.dev"}},
+				"trailer": {"value": {"/Root": "1 0 R", "/AA": {}}}
+			}
+		]
+	}`
+
+	for _, tc := range []struct {
+		scenario     string
+		data         string
+		options      gotenberg.SanitizeOptions
+		expectUpdate string
+		expectError  bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			options:     gotenberg.SanitizeOptions{JavaScript: true},
+			expectError: true,
+		},
+		{
+			scenario:    "missing objects",
+			data:        `{"qpdf":[{"jsonversion":2}]}`,
+			options:     gotenberg.SanitizeOptions{JavaScript: true},
+			expectError: true,
+		},
+		{
+			scenario: "nothing to sanitize",
+			data:     data,
+		},
+		{
+			scenario: "JavaScript",
+			data:     data,
+			options:  gotenberg.SanitizeOptions{JavaScript: true},
+			expectUpdate: `{"qpdf": [
+				{"jsonversion": 2, "maxobjectid": 10},
+				{
+					"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/Names": {"/EmbeddedFiles": "7 0 R"}, "/AcroForm": {"/Fields": [], "/XFA": "8 0 R"}}},
+					"obj:3 0 R": {"value": {"/Type": "/Page", "/Parent": "2 0 R", "/MediaBox": [0, 0, 595.0, 842], "/Annots": ["4 0 R", "9 0 R"]}},
+					"obj:4 0 R": {"value": {"/Type": "/Annot", "/Subtype": "/Link", "/A": {"/S": "/Launch", "/F": "u:calc.exe", "/Next": ["10 0 R"]}}}
+				}
+			]}`,
+		},
+		{
+			scenario: "launch actions",
+			data:     data,
+			options:  gotenberg.SanitizeOptions{LaunchActions: true},
+			expectUpdate: `{"qpdf": [
+				{"jsonversion": 2, "maxobjectid": 10},
+				{
+					"obj:4 0 R": {"value": {"/Type": "/Annot", "/Subtype": "/Link"}}
+				}
+			]}`,
+		},
+		{
+			scenario: "embedded files",
+			data:     data,
+			options:  gotenberg.SanitizeOptions{EmbeddedFiles: true},
+			expectUpdate: `{"qpdf": [
+				{"jsonversion": 2, "maxobjectid": 10},
+				{
+					"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/OpenAction": "5 0 R", "/Names": {"/JavaScript": "6 0 R"}, "/AcroForm": {"/Fields": [], "/XFA": "8 0 R"}}},
+					"obj:8 0 R": {"stream": {"dict": {}}},
+					"obj:9 0 R": {"value": {"/Type": "/Annot", "/Subtype": "/FileAttachment"}}
+				}
+			]}`,
+		},
+		{
+			scenario: "XFA",
+			data:     data,
+			options:  gotenberg.SanitizeOptions{Xfa: true},
+			expectUpdate: `{"qpdf": [
+				{"jsonversion": 2, "maxobjectid": 10},
+				{
+					"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/OpenAction": "5 0 R", "/Names": {"/JavaScript": "6 0 R", "/EmbeddedFiles": "7 0 R"}, "/AcroForm": {"/Fields": []}}}
+				}
+			]}`,
+		},
+		{
+			scenario: "annotations",
+			data:     data,
+			options:  gotenberg.SanitizeOptions{Annotations: true},
+			expectUpdate: `{"qpdf": [
+				{"jsonversion": 2, "maxobjectid": 10},
+				{
+					"obj:1 0 R": {"value": {"/Type": "/Catalog", "/Pages": "2 0 R", "/OpenAction": "5 0 R", "/Names": {"/JavaScript": "6 0 R", "/EmbeddedFiles": "7 0 R"}}},
+					"obj:3 0 R": {"value": {"/Type": "/Page", "/Parent": "2 0 R", "/MediaBox": [0, 0, 595.0, 842], "/AA": {"/O": "5 0 R"}}}
+				}
+			]}`,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			update, err := sanitizeObjects([]byte(tc.data), tc.options)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if tc.expectError {
+				return
+			}
+
+			if tc.expectUpdate == "" {
+				if update != nil {
+					t.Fatalf("expected no update but got: %s", update)
+				}
+				return
+			}
+
+			var expected, actual interface{}
+			err = json.Unmarshal([]byte(tc.expectUpdate), &expected)
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			err = json.Unmarshal(update, &actual)
+			if err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected %s but got: %s", tc.expectUpdate, update)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Package verapdf provides an implementation of the gotenberg.PdfEngine
+// interface using the veraPDF command-line tool. This package allows for:
+//
+// 1. The validation of PDF files against the PDF/A and PDF/UA conformance
+// profiles.
+//
+// The path to the veraPDF binary must be specified using the
+// VERAPDF_BIN_PATH environment variable. Otherwise, the module is not
+// available.
+//
+// See: https://verapdf.org.
+package verapdf
@@ -0,0 +1,131 @@
+package verapdf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+// validationFlavours returns the veraPDF flavours matching the given formats.
+// An empty flavour lets veraPDF pick the profile from the PDF/A claim of the
+// XMP metadata.
+func validationFlavours(formats gotenberg.PdfFormats) ([]string, error) {
+	zeroValued := gotenberg.PdfFormats{}
+	if formats == zeroValued {
+		return []string{""}, nil
+	}
+
+	var flavours []string
+
+	if formats.PdfA != "" {
+		switch formats.PdfA {
+		case gotenberg.PdfA1a, gotenberg.PdfA1b, gotenberg.PdfA2a, gotenberg.PdfA2b, gotenberg.PdfA2u, gotenberg.PdfA3a, gotenberg.PdfA3b, gotenberg.PdfA3u, gotenberg.PdfA4, gotenberg.PdfA4f:
+			// e.g., "PDF/A-2b" gives "2b".
+			flavours = append(flavours, strings.ToLower(strings.TrimPrefix(formats.PdfA, "PDF/A-")))
+		default:
+			return nil, fmt.Errorf("validate PDF against '%+v' with veraPDF: %w", formats, gotenberg.ErrPdfFormatNotSupported)
+		}
+	}
+
+	if formats.PdfUa {
+		flavours = append(flavours, "ua1")
+	}
+
+	return flavours, nil
+}
+
+// veraPdfReport represents the relevant parts of the JSON report of veraPDF
+// for a single PDF file.
+type veraPdfReport struct {
+	Report struct {
+		Jobs []struct {
+			// The validation result is an object in older versions of
+			// veraPDF, and an array in newer ones.
+			ValidationResult json.RawMessage `json:"validationResult"`
+			TaskException    *struct {
+				Message string `json:"exceptionMessage"`
+			} `json:"taskException"`
+		} `json:"jobs"`
+	} `json:"report"`
+}
+
+type veraPdfValidationResult struct {
+	ProfileName string `json:"profileName"`
+	Compliant   bool   `json:"compliant"`
+	Details     struct {
+		RuleSummaries []struct {
+			Specification string `json:"specification"`
+			Clause        string `json:"clause"`
+			TestNumber    int    `json:"testNumber"`
+			Status        string `json:"status"`
+			Description   string `json:"description"`
+			FailedChecks  int    `json:"failedChecks"`
+		} `json:"ruleSummaries"`
+	} `json:"details"`
+}
+
+// parseReport parses the JSON report of veraPDF for a single PDF file.
+func parseReport(data []byte) (gotenberg.PdfValidationReport, error) {
+	var report veraPdfReport
+	err := json.Unmarshal(data, &report)
+	if err != nil {
+		return gotenberg.PdfValidationReport{}, fmt.Errorf("unmarshal veraPDF JSON: %w", err)
+	}
+
+	if len(report.Report.Jobs) != 1 {
+		return gotenberg.PdfValidationReport{}, fmt.Errorf("expected 1 job, got %d", len(report.Report.Jobs))
+	}
+
+	job := report.Report.Jobs[0]
+	if job.TaskException != nil {
+		return gotenberg.PdfValidationReport{}, fmt.Errorf("veraPDF task exception: %s", job.TaskException.Message)
+	}
+
+	raw := bytes.TrimSpace(job.ValidationResult)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return gotenberg.PdfValidationReport{}, errors.New("no validation result")
+	}
+
+	var results []veraPdfValidationResult
+	if raw[0] == '[' {
+		err = json.Unmarshal(raw, &results)
+	} else {
+		var result veraPdfValidationResult
+		err = json.Unmarshal(raw, &result)
+		results = append(results, result)
+	}
+	if err != nil {
+		return gotenberg.PdfValidationReport{}, fmt.Errorf("unmarshal veraPDF validation result: %w", err)
+	}
+
+	if len(results) != 1 {
+		return gotenberg.PdfValidationReport{}, fmt.Errorf("expected 1 validation result, got %d", len(results))
+	}
+
+	result := results[0]
+	validationReport := gotenberg.PdfValidationReport{
+		Profile:    result.ProfileName,
+		Compliant:  result.Compliant,
+		Violations: make([]gotenberg.PdfRuleViolation, 0),
+	}
+
+	for _, rule := range result.Details.RuleSummaries {
+		if !strings.EqualFold(rule.Status, "failed") {
+			continue
+		}
+
+		validationReport.Violations = append(validationReport.Violations, gotenberg.PdfRuleViolation{
+			Specification: rule.Specification,
+			Clause:        rule.Clause,
+			TestNumber:    rule.TestNumber,
+			Description:   rule.Description,
+			FailedChecks:  rule.FailedChecks,
+		})
+	}
+
+	return validationReport, nil
+}
@@ -0,0 +1,162 @@
+package verapdf
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func TestValidationFlavours(t *testing.T) {
+	for _, tc := range []struct {
+		scenario        string
+		formats         gotenberg.PdfFormats
+		expectFlavours  []string
+		expectError     bool
+		expectFormatErr bool
+	}{
+		{
+			scenario:       "no formats",
+			expectFlavours: []string{""},
+		},
+		{
+			scenario:       "PDF/A",
+			formats:        gotenberg.PdfFormats{PdfA: gotenberg.PdfA2b},
+			expectFlavours: []string{"2b"},
+		},
+		{
+			scenario:       "PDF/UA",
+			formats:        gotenberg.PdfFormats{PdfUa: true},
+			expectFlavours: []string{"ua1"},
+		},
+		{
+			scenario:       "PDF/A and PDF/UA",
+			formats:        gotenberg.PdfFormats{PdfA: gotenberg.PdfA3u, PdfUa: true},
+			expectFlavours: []string{"3u", "ua1"},
+		},
+		{
+			scenario:       "PDF/A-4f",
+			formats:        gotenberg.PdfFormats{PdfA: gotenberg.PdfA4f},
+			expectFlavours: []string{"4f"},
+		},
+		{
+			scenario:        "unsupported PDF/A",
+			formats:         gotenberg.PdfFormats{PdfA: "PDF/A-5"},
+			expectError:     true,
+			expectFormatErr: true,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			flavours, err := validationFlavours(tc.formats)
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if tc.expectFormatErr && !errors.Is(err, gotenberg.ErrPdfFormatNotSupported) {
+				t.Fatalf("expected error %v but got: %v", gotenberg.ErrPdfFormatNotSupported, err)
+			}
+
+			if !reflect.DeepEqual(flavours, tc.expectFlavours) {
+				t.Errorf("expected %v but got: %v", tc.expectFlavours, flavours)
+			}
+		})
+	}
+}
+
+func TestParseReport(t *testing.T) {
+	for _, tc := range []struct {
+		scenario     string
+		data         string
+		expectReport gotenberg.PdfValidationReport
+		expectError  bool
+	}{
+		{
+			scenario:    "invalid JSON",
+			data:        "foo",
+			expectError: true,
+		},
+		{
+			scenario:    "no job",
+			data:        `{"report":{"jobs":[]}}`,
+			expectError: true,
+		},
+		{
+			scenario:    "task exception",
+			data:        `{"report":{"jobs":[{"taskException":{"exceptionMessage":"foo"}}]}}`,
+			expectError: true,
+		},
+		{
+			scenario:    "no validation result",
+			data:        `{"report":{"jobs":[{"itemDetails":{"name":"foo.pdf"}}]}}`,
+			expectError: true,
+		},
+		{
+			scenario: "compliant",
+			data:     `{"report":{"jobs":[{"validationResult":[{"profileName":"PDF/A-2B validation profile","compliant":true,"details":{"failedRules":0,"ruleSummaries":[]}}]}]}}`,
+			expectReport: gotenberg.PdfValidationReport{
+				Profile:    "PDF/A-2B validation profile",
+				Compliant:  true,
+				Violations: []gotenberg.PdfRuleViolation{},
+			},
+		},
+		{
+			scenario: "not compliant",
+			data: `{"report":{"jobs":[{"validationResult":[{"profileName":"PDF/A-2B validation profile","compliant":false,"details":{"ruleSummaries":[
+				{"ruleStatus":"FAILED","specification":"ISO 19005-2:2011","clause":"6.2.11.4.1","testNumber":1,"status":"failed","failedChecks":2,"description":"The font programs for all fonts used for rendering within a conforming file shall be embedded"},
+				{"ruleStatus":"PASSED","specification":"ISO 19005-2:2011","clause":"6.1.2","testNumber":1,"status":"passed","failedChecks":0,"description":"foo"}
+			]}}]}]}}`,
+			expectReport: gotenberg.PdfValidationReport{
+				Profile:   "PDF/A-2B validation profile",
+				Compliant: false,
+				Violations: []gotenberg.PdfRuleViolation{
+					{
+						Specification: "ISO 19005-2:2011",
+						Clause:        "6.2.11.4.1",
+						TestNumber:    1,
+						Description:   "The font programs for all fonts used for rendering within a conforming file shall be embedded",
+						FailedChecks:  2,
+					},
+				},
+			},
+		},
+		{
+			scenario: "validation result as an object",
+			data:     `{"report":{"jobs":[{"validationResult":{"profileName":"PDF/UA-1 validation profile","compliant":false,"details":{"ruleSummaries":[{"specification":"ISO 14289-1:2014","clause":"7.1","testNumber":3,"status":"FAILED","failedChecks":1,"description":"foo"}]}}}]}}`,
+			expectReport: gotenberg.PdfValidationReport{
+				Profile:   "PDF/UA-1 validation profile",
+				Compliant: false,
+				Violations: []gotenberg.PdfRuleViolation{
+					{
+						Specification: "ISO 14289-1:2014",
+						Clause:        "7.1",
+						TestNumber:    3,
+						Description:   "foo",
+						FailedChecks:  1,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			report, err := parseReport([]byte(tc.data))
+
+			if !tc.expectError && err != nil {
+				t.Fatalf("expected no error but got: %v", err)
+			}
+
+			if tc.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+
+			if !reflect.DeepEqual(report, tc.expectReport) {
+				t.Errorf("expected %+v but got: %+v", tc.expectReport, report)
+			}
+		})
+	}
+}
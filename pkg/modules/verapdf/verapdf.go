@@ -0,0 +1,302 @@
+package verapdf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/gotenberg/gotenberg/v8/pkg/gotenberg"
+)
+
+func init() {
+	gotenberg.MustRegisterModule(new(VeraPdf))
+}
+
+// VeraPdf abstracts the CLI tool veraPDF and implements the
+// [gotenberg.PdfEngine] interface.
+type VeraPdf struct {
+	binPath string
+}
+
+// Descriptor returns a [VeraPdf]'s module descriptor.
+func (engine *VeraPdf) Descriptor() gotenberg.ModuleDescriptor {
+	return gotenberg.ModuleDescriptor{
+		ID:  "verapdf",
+		New: func() gotenberg.Module { return new(VeraPdf) },
+	}
+}
+
+// Provision sets the module properties. If the VERAPDF_BIN_PATH environment
+// variable is not set, the module is not available.
+func (engine *VeraPdf) Provision(ctx *gotenberg.Context) error {
+	engine.binPath = os.Getenv("VERAPDF_BIN_PATH")
+
+	return nil
+}
+
+// Validate validates the module properties.
+func (engine *VeraPdf) Validate() error {
+	if !engine.Available() {
+		return nil
+	}
+
+	_, err := os.Stat(engine.binPath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("veraPDF binary path does not exist: %w", err)
+	}
+
+	return nil
+}
+
+// Available tells whether the veraPDF binary is available.
+func (engine *VeraPdf) Available() bool {
+	return engine.binPath != ""
+}
+
+// Debug returns additional debug data.
+func (engine *VeraPdf) Debug() map[string]interface{} {
+	debug := make(map[string]interface{})
+
+	cmd := exec.Command(engine.binPath, "--version") //nolint:gosec
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	output, err := cmd.Output()
+	if err != nil {
+		debug["version"] = err.Error()
+		return debug
+	}
+
+	debug["version"] = "Unable to determine veraPDF version"
+
+	// e.g., "veraPDF 1.26.2".
+	lines := strings.Split(string(output), "\n")
+	for _, line := range lines {
+		if strings.HasPrefix(line, "veraPDF ") {
+			debug["version"] = strings.TrimSpace(strings.TrimPrefix(line, "veraPDF "))
+			break
+		}
+	}
+
+	return debug
+}
+
+// Merge is not available in this implementation.
+func (engine *VeraPdf) Merge(ctx context.Context, logger *zap.Logger, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("merge PDFs with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Split is not available in this implementation.
+func (engine *VeraPdf) Split(ctx context.Context, logger *zap.Logger, mode gotenberg.SplitMode, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("split PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Flatten is not available in this implementation.
+func (engine *VeraPdf) Flatten(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("flatten PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Convert is not available in this implementation.
+func (engine *VeraPdf) Convert(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath, outputPath string) error {
+	return fmt.Errorf("convert PDF to '%+v' with veraPDF: %w", formats, gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadMetadata is not available in this implementation.
+func (engine *VeraPdf) ReadMetadata(ctx context.Context, logger *zap.Logger, inputPath string) (map[string]interface{}, error) {
+	return nil, fmt.Errorf("read PDF metadata with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteMetadata is not available in this implementation.
+func (engine *VeraPdf) WriteMetadata(ctx context.Context, logger *zap.Logger, metadata map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("write PDF metadata with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Encrypt is not available in this implementation.
+func (engine *VeraPdf) Encrypt(ctx context.Context, logger *zap.Logger, options gotenberg.EncryptOptions, inputPath string) error {
+	return fmt.Errorf("encrypt PDF using veraPDF: %w", gotenberg.ErrPdfEncryptionNotSupported)
+}
+
+// EmbedFiles is not available in this implementation.
+func (engine *VeraPdf) EmbedFiles(ctx context.Context, logger *zap.Logger, filePaths []string, inputPath string) error {
+	return fmt.Errorf("embed files with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rotate is not available in this implementation.
+func (engine *VeraPdf) Rotate(ctx context.Context, logger *zap.Logger, angle int, pages, inputPath string) error {
+	return fmt.Errorf("rotate PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Watermark is not available in this implementation.
+func (engine *VeraPdf) Watermark(ctx context.Context, logger *zap.Logger, watermark gotenberg.Watermark, inputPath string) error {
+	return fmt.Errorf("watermark PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Overlay is not available in this implementation.
+func (engine *VeraPdf) Overlay(ctx context.Context, logger *zap.Logger, overlay gotenberg.Overlay, inputPath string) error {
+	return fmt.Errorf("overlay PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Info is not available in this implementation.
+func (engine *VeraPdf) Info(ctx context.Context, logger *zap.Logger, inputPath string) (gotenberg.PdfInfo, error) {
+	return gotenberg.PdfInfo{}, fmt.Errorf("get PDF info with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Optimize is not available in this implementation.
+func (engine *VeraPdf) Optimize(ctx context.Context, logger *zap.Logger, options gotenberg.OptimizeOptions, inputPath string) error {
+	return fmt.Errorf("optimize PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Decrypt is not available in this implementation.
+func (engine *VeraPdf) Decrypt(ctx context.Context, logger *zap.Logger, inputPath, password string) error {
+	return fmt.Errorf("decrypt PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadBookmarks is not available in this implementation.
+func (engine *VeraPdf) ReadBookmarks(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.Bookmark, error) {
+	return nil, fmt.Errorf("read PDF bookmarks with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// WriteBookmarks is not available in this implementation.
+func (engine *VeraPdf) WriteBookmarks(ctx context.Context, logger *zap.Logger, bookmarks []gotenberg.Bookmark, inputPath string) error {
+	return fmt.Errorf("write PDF bookmarks with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// FillForm is not available in this implementation.
+func (engine *VeraPdf) FillForm(ctx context.Context, logger *zap.Logger, values map[string]interface{}, inputPath string) error {
+	return fmt.Errorf("fill PDF form with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ReadFormFields is not available in this implementation.
+func (engine *VeraPdf) ReadFormFields(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.FormField, error) {
+	return nil, fmt.Errorf("read PDF form fields with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ListEmbeds is not available in this implementation.
+func (engine *VeraPdf) ListEmbeds(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.EmbeddedFile, error) {
+	return nil, fmt.Errorf("list embedded files with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractEmbeds is not available in this implementation.
+func (engine *VeraPdf) ExtractEmbeds(ctx context.Context, logger *zap.Logger, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract embedded files with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractImages is not available in this implementation.
+func (engine *VeraPdf) ExtractImages(ctx context.Context, logger *zap.Logger, pages, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("extract images with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sanitize is not available in this implementation.
+func (engine *VeraPdf) Sanitize(ctx context.Context, logger *zap.Logger, options gotenberg.SanitizeOptions, inputPath string) error {
+	return fmt.Errorf("sanitize PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ImportImages is not available in this implementation.
+func (engine *VeraPdf) ImportImages(ctx context.Context, logger *zap.Logger, options gotenberg.ImportImagesOptions, inputPaths []string, outputPath string) error {
+	return fmt.Errorf("import images with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ExtractText is not available in this implementation.
+func (engine *VeraPdf) ExtractText(ctx context.Context, logger *zap.Logger, pageRanges, inputPath string) ([]gotenberg.PdfPageText, error) {
+	return nil, fmt.Errorf("extract text with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Impose is not available in this implementation.
+func (engine *VeraPdf) Impose(ctx context.Context, logger *zap.Logger, options gotenberg.ImposeOptions, inputPath, outputPath string) error {
+	return fmt.Errorf("impose PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// PageEdit is not available in this implementation.
+func (engine *VeraPdf) PageEdit(ctx context.Context, logger *zap.Logger, instructions []gotenberg.PageEditInstruction, inputPath string) error {
+	return fmt.Errorf("edit PDF pages with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Rasterize is not available in this implementation.
+func (engine *VeraPdf) Rasterize(ctx context.Context, logger *zap.Logger, options gotenberg.RasterizeOptions, inputPath, outputDirPath string) ([]string, error) {
+	return nil, fmt.Errorf("rasterize PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Sign is not available in this implementation.
+func (engine *VeraPdf) Sign(ctx context.Context, logger *zap.Logger, options gotenberg.SignOptions, inputPath string) error {
+	return fmt.Errorf("sign PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// VerifySignatures is not available in this implementation.
+func (engine *VeraPdf) VerifySignatures(ctx context.Context, logger *zap.Logger, inputPath string) ([]gotenberg.PdfSignature, error) {
+	return nil, fmt.Errorf("verify PDF signatures with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// ValidateCompliance validates a PDF file against the veraPDF validation
+// profiles of the given formats. Without formats, veraPDF picks the profile
+// from the PDF/A claim of the XMP metadata.
+func (engine *VeraPdf) ValidateCompliance(ctx context.Context, logger *zap.Logger, formats gotenberg.PdfFormats, inputPath string) ([]gotenberg.PdfValidationReport, error) {
+	flavours, err := validationFlavours(formats)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]gotenberg.PdfValidationReport, 0, len(flavours))
+	for _, flavour := range flavours {
+		var args []string
+		args = append(args, "--format", "json")
+
+		if flavour != "" {
+			args = append(args, "--flavour", flavour)
+		}
+
+		args = append(args, inputPath)
+
+		cmd, err := gotenberg.CommandContext(ctx, logger, engine.binPath, args...)
+		if err != nil {
+			return nil, fmt.Errorf("create command: %w", err)
+		}
+
+		var stdout bytes.Buffer
+		cmd.SetStdout(&stdout)
+
+		// veraPDF exits with the code 1 if the PDF file is not compliant.
+		exitCode, err := cmd.Exec()
+		if err != nil && exitCode != 1 {
+			return nil, fmt.Errorf("validate PDF with veraPDF: %w", err)
+		}
+
+		report, err := parseReport(stdout.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("parse veraPDF report: %w", err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Repair is not available in this implementation.
+func (engine *VeraPdf) Repair(ctx context.Context, logger *zap.Logger, inputPath string) error {
+	return fmt.Errorf("repair PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Resize is not available in this implementation.
+func (engine *VeraPdf) Resize(ctx context.Context, logger *zap.Logger, options gotenberg.ResizeOptions, inputPath string) error {
+	return fmt.Errorf("resize PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Crop is not available in this implementation.
+func (engine *VeraPdf) Crop(ctx context.Context, logger *zap.Logger, options gotenberg.CropOptions, inputPath string) error {
+	return fmt.Errorf("crop PDF with veraPDF: %w", gotenberg.ErrPdfEngineMethodNotSupported)
+}
+
+// Interface guards.
+var (
+	_ gotenberg.Module            = (*VeraPdf)(nil)
+	_ gotenberg.Provisioner       = (*VeraPdf)(nil)
+	_ gotenberg.Validator         = (*VeraPdf)(nil)
+	_ gotenberg.Debuggable        = (*VeraPdf)(nil)
+	_ gotenberg.PdfEngine         = (*VeraPdf)(nil)
+	_ gotenberg.OptionalPdfEngine = (*VeraPdf)(nil)
+)
@@ -12,7 +12,11 @@ import (
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pdfcpu"
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pdfengines"
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pdftk"
+	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pdftoppm"
+	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pdftotext"
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/prometheus"
+	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/pyhanko"
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/qpdf"
+	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/verapdf"
 	_ "github.com/gotenberg/gotenberg/v8/pkg/modules/webhook"
 )
@@ -0,0 +1,100 @@
+@chromium
+@chromium-stamp-pdf
+Feature: /forms/chromium/stamp/pdf
+
+  Scenario: POST /forms/chromium/stamp/pdf (Footer)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files | testdata/pages_3.pdf                    | file |
+      | files | testdata/header-footer-html/footer.html | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | pages_3.pdf |
+    Then the "pages_3.pdf" PDF should have 3 page(s)
+    Then the "pages_3.pdf" PDF should have the following content at page 1:
+      """
+      Page 1
+      """
+    Then the "pages_3.pdf" PDF should have the following content at page 1:
+      """
+      1 of 3
+      """
+    Then the "pages_3.pdf" PDF should have the following content at page 3:
+      """
+      3 of 3
+      """
+
+  Scenario: POST /forms/chromium/stamp/pdf (Header & Footer)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files        | testdata/page_1.pdf                     | file  |
+      | files        | testdata/pages_3.pdf                    | file  |
+      | files        | testdata/header-footer-html/header.html | file  |
+      | files        | testdata/header-footer-html/footer.html | file  |
+      | marginTop    | 1cm                                     | field |
+      | marginBottom | 1cm                                     | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf  |
+      | pages_3.pdf |
+    Then the "page_1.pdf" PDF should have the following content at page 1:
+      """
+      1 of 1
+      """
+    Then the "pages_3.pdf" PDF should have the following content at page 2:
+      """
+      2 of 3
+      """
+
+  Scenario: POST /forms/chromium/stamp/pdf (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files | testdata/header-footer-html/footer.html | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: either 'header.html' or 'footer.html' form files must be provided
+      """
+
+  Scenario: POST /forms/chromium/stamp/pdf (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | CHROMIUM_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf                     | file |
+      | files | testdata/header-footer-html/footer.html | file |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/chromium/stamp/pdf (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf                     | file   |
+      | files           | testdata/header-footer-html/footer.html | file   |
+      | Gotenberg-Trace | forms_chromium_stamp_pdf                | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_chromium_stamp_pdf"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_chromium_stamp_pdf" |
+
+  Scenario: POST /forms/chromium/stamp/pdf (Basic Auth)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | API_ENABLE_BASIC_AUTH             | true |
+      | GOTENBERG_API_BASIC_AUTH_USERNAME | foo  |
+      | GOTENBERG_API_BASIC_AUTH_PASSWORD | bar  |
+    When I make a "POST" request to Gotenberg at the "/forms/chromium/stamp/pdf" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf                     | file |
+      | files | testdata/header-footer-html/footer.html | file |
+    Then the response status code should be 401
@@ -28,8 +28,12 @@ Feature: /debug
           "pdfcpu",
           "pdfengines",
           "pdftk",
+          "pdftoppm",
+          "pdftotext",
           "prometheus",
+          "pyhanko",
           "qpdf",
+          "verapdf",
           "webhook"
         ],
         "modules_additional_data": {
@@ -48,8 +52,20 @@ Feature: /debug
           "pdftk": {
             "version": "ignore"
           },
+          "pdftoppm": {
+            "version": "ignore"
+          },
+          "pdftotext": {
+            "version": "ignore"
+          },
+          "pyhanko": {
+            "version": "ignore"
+          },
           "qpdf": {
             "version": "ignore"
+          },
+          "verapdf": {
+            "version": "ignore"
           }
         },
         "flags": {
@@ -297,6 +297,40 @@ Feature: /forms/libreoffice/convert
       Page 2
       """
 
+  @merge
+  @bookmarks
+  Scenario: POST /forms/libreoffice/convert (Merge & Bookmarks Per File)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/libreoffice/convert" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.docx    | file   |
+      | files                     | testdata/page_2.docx    | file   |
+      | merge                     | true                    | field  |
+      | bookmarksPerFile          | true                    | field  |
+      | bookmarkLabels            | {"page_2.docx":"Annex"} | field  |
+      | Gotenberg-Output-Filename | foo                     | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 2 page(s)
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | teststore/foo.pdf | file |
+    Then the response status code should be 200
+    Then the response body should match JSON:
+      """
+      {
+        "foo.pdf": [
+          {
+            "title": "page_1",
+            "page": 1
+          },
+          {
+            "title": "Annex",
+            "page": 2
+          }
+        ]
+      }
+      """
+
   @merge
   @split
   Scenario: POST /forms/libreoffice/convert (Merge & Split)
@@ -460,6 +494,18 @@ Feature: /forms/libreoffice/convert
     Then the response PDF(s) should be valid "PDF/A-1b" with a tolerance of 1 failed rule(s)
     Then the response PDF(s) should be valid "PDF/UA-1" with a tolerance of 3 failed rule(s)
 
+  @convert
+  Scenario: POST /forms/libreoffice/convert (PDF/A-1b & Compress)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/libreoffice/convert" endpoint with the following form data and header(s):
+      | files    | testdata/page_1.docx | file  |
+      | pdfa     | PDF/A-1b             | field |
+      | compress | true                 | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be valid "PDF/A-1b" with a tolerance of 1 failed rule(s)
+
   @split
   @convert
   Scenario: POST /forms/libreoffice/convert (Split & PDF/A-1b & PDF/UA-1)
@@ -0,0 +1,164 @@
+@pdfengines
+@pdfengines-bookmarks
+@bookmarks
+Feature: /forms/pdfengines/bookmarks/{write|read}
+
+  Scenario: POST /forms/pdfengines/bookmarks/{write|read} (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf                                                                                          | file   |
+      | bookmarks                 | [{"title":"Chapter 1","page":1,"children":[{"title":"Section 1.1","page":2}]},{"title":"Chapter 2","page":3}] | field  |
+      | Gotenberg-Output-Filename | foo                                                                                                           | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | teststore/foo.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "foo.pdf": [
+          {
+            "title": "Chapter 1",
+            "page": 1,
+            "children": [
+              {
+                "title": "Section 1.1",
+                "page": 2
+              }
+            ]
+          },
+          {
+            "title": "Chapter 2",
+            "page": 3
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/bookmarks/{write|read} (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files     | testdata/page_1.pdf          | file  |
+      | files     | testdata/page_2.pdf          | file  |
+      | bookmarks | [{"title":"Cover","page":1}] | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | teststore/page_1.pdf | file |
+      | files | teststore/page_2.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [
+          {
+            "title": "Cover",
+            "page": 1
+          }
+        ],
+        "page_2.pdf": [
+          {
+            "title": "Cover",
+            "page": 1
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/bookmarks/read (No Bookmarks)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": []
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/bookmarks/write (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'bookmarks' is required; no form file found for extensions: [.pdf]
+      """
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files     | testdata/page_1.pdf | file  |
+      | bookmarks | foo                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'bookmarks' is invalid (got 'foo', resulting to unmarshal bookmarks: invalid character 'o' in literal false (expecting 'a'))
+      """
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files     | testdata/page_1.pdf          | file  |
+      | bookmarks | [{"title":"Cover","page":0}] | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'bookmarks' is invalid (got '[{"title":"Cover","page":0}]', resulting to bookmark 'Cover' page must be greater than 0)
+      """
+
+  Scenario: POST /forms/pdfengines/bookmarks/read (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/bookmarks/write (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files     | testdata/page_1.pdf          | file  |
+      | bookmarks | [{"title":"Cover","page":1}] | field |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/bookmarks/read (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/bookmarks/write (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf              | file   |
+      | bookmarks       | [{"title":"Cover","page":1}]     | field  |
+      | Gotenberg-Trace | forms_pdfengines_bookmarks_write | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_bookmarks_write"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_bookmarks_write" |
+
+  Scenario: POST /forms/pdfengines/bookmarks/read (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf             | file   |
+      | Gotenberg-Trace | forms_pdfengines_bookmarks_read | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_bookmarks_read"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_bookmarks_read" |
@@ -35,6 +35,16 @@ Feature: /forms/pdfengines/convert
     Then there should be 1 PDF(s) in the response
     Then the response PDF(s) should be valid "PDF/A-3b" with a tolerance of 1 failed rule(s)
 
+  Scenario: POST /forms/pdfengines/convert (Single PDF/A-4)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/convert" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | pdfa  | PDF/A-4             | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be valid "PDF/A-4" with a tolerance of 1 failed rule(s)
+
   Scenario: POST /forms/pdfengines/convert (Single PDF/UA-1)
     Given I have a default Gotenberg container
     When I make a "POST" request to Gotenberg at the "/forms/pdfengines/convert" endpoint with the following form data and header(s):
@@ -91,6 +101,24 @@ Feature: /forms/pdfengines/convert
       | pdfa  | foo                 | field |
     Then the response status code should be 400
     Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      At least one PDF engine cannot process the requested PDF format, while others may have failed to convert due to different issues
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/convert" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | pdfa  | PDF/A-4f            | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      At least one PDF engine cannot process the requested PDF format, while others may have failed to convert due to different issues
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/convert" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | pdfa  | PDF/A-2u            | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
     Then the response body should match string:
       """
       At least one PDF engine cannot process the requested PDF format, while others may have failed to convert due to different issues
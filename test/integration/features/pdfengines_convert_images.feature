@@ -0,0 +1,85 @@
+@pdfengines
+@pdfengines-convert-images
+@convert-images
+Feature: /forms/pdfengines/images/convert
+
+  Scenario: POST /forms/pdfengines/images/convert (Single Image)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | files                     | testdata/image_1.png | file   |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 1 page(s)
+
+  Scenario: POST /forms/pdfengines/images/convert (Many Images)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | files                     | testdata/image_1.png | file   |
+      | files                     | testdata/image_2.jpg | file   |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.zip         |
+      | image_1.png.pdf |
+      | image_2.jpg.pdf |
+
+  Scenario: POST /forms/pdfengines/images/convert (Merge)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | files                     | testdata/image_2.jpg | file   |
+      | files                     | testdata/image_1.png | file   |
+      | merge                     | true                 | field  |
+      | pageSize                  | A4                   | field  |
+      | landscape                 | true                 | field  |
+      | fit                       | true                 | field  |
+      | center                    | true                 | field  |
+      | dpi                       | 150                  | field  |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 2 page(s)
+    Then the "foo.pdf" PDF should be set to landscape orientation
+
+  Scenario: POST /forms/pdfengines/images/convert (Metadata & Encrypt)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | files                     | testdata/image_1.png        | file   |
+      | metadata                  | {"Author":"Julien Neuhart"} | field  |
+      | userPassword              | foo                         | field  |
+      | Gotenberg-Output-Filename | foo                         | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the response PDF(s) should be encrypted
+
+  Scenario: POST /forms/pdfengines/images/convert (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | pageSize                  | A42 | field  |
+      | dpi                       | 0   | field  |
+      | merge                     | foo | field  |
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'pageSize' is invalid (got 'A42', resulting to wrong value, expected an ISO A, B or C size (e.g., 'A4'), 'Letter', 'Legal', 'Ledger' or 'Tabloid'); form field 'dpi' is invalid (got '0', resulting to value must be greater than 0); no form file found for extensions: [.jpg .jpeg .png .tif .tiff]; form field 'merge' is invalid (got 'foo', resulting to strconv.ParseBool: parsing "foo": invalid syntax)
+      """
+
+  Scenario: POST /forms/pdfengines/images/convert (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/convert" endpoint with the following form data and header(s):
+      | files | testdata/image_1.png | file |
+    Then the response status code should be 404
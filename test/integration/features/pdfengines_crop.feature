@@ -0,0 +1,83 @@
+@pdfengines
+@pdfengines-crop
+@crop
+Feature: /forms/pdfengines/crop
+
+  Scenario: POST /forms/pdfengines/crop (Crop Box)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file  |
+      | cropBox | 10.5pt 10pt         | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have its page 1 "CropBox" at "10 11 436 621"
+
+  Scenario: POST /forms/pdfengines/crop (Trim & Bleed Boxes)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files    | testdata/page_1.pdf | file  |
+      | files    | testdata/page_2.pdf | file  |
+      | trimBox  | 5mm                 | field |
+      | bleedBox | 2mm                 | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+
+  Scenario: POST /forms/pdfengines/crop (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | cropBox | 1cm | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: either 'cropBox', 'trimBox' or 'bleedBox' form fields must be provided
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file  |
+      | cropBox | 1cm 1cm 1cm 1cm 1cm | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'cropBox' is invalid (got '1cm 1cm 1cm 1cm 1cm', resulting to wrong value, expected from one to four margins)
+      """
+
+  Scenario: POST /forms/pdfengines/crop (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file  |
+      | cropBox | 1cm                 | field |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/crop (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf   | file   |
+      | cropBox         | 1cm                   | field  |
+      | Gotenberg-Trace | forms_pdfengines_crop | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_crop"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_crop" |
+
+  Scenario: POST /forms/pdfengines/crop (Basic Auth)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | API_ENABLE_BASIC_AUTH             | true |
+      | GOTENBERG_API_BASIC_AUTH_USERNAME | foo  |
+      | GOTENBERG_API_BASIC_AUTH_PASSWORD | bar  |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/crop" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file  |
+      | cropBox | 1cm                 | field |
+    Then the response status code should be 401
@@ -0,0 +1,96 @@
+@pdfengines
+@pdfengines-decrypt
+@decrypt
+Feature: /forms/pdfengines/decrypt
+
+  Scenario: POST /forms/pdfengines/decrypt (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | userPassword    | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_encrypt | header |
+    Then the response status code should be 200
+    Then the response PDF(s) should be encrypted
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/decrypt" endpoint with the following form data and header(s):
+      | files           | teststore/page_1.pdf     | file   |
+      | password        | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_decrypt | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should NOT be encrypted
+
+  Scenario: POST /forms/pdfengines/decrypt (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | files           | testdata/page_2.pdf      | file   |
+      | userPassword    | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_encrypt | header |
+    Then the response status code should be 200
+    Then there should be 2 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/decrypt" endpoint with the following form data and header(s):
+      | files           | teststore/page_1.pdf     | file   |
+      | files           | teststore/page_2.pdf     | file   |
+      | password        | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_decrypt | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then the response PDF(s) should NOT be encrypted
+
+  Scenario: POST /forms/pdfengines/decrypt (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/decrypt" endpoint with the following form data and header(s):
+      | passwords                 | foo | field  |
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'passwords' is invalid (got 'foo', resulting to unmarshal passwords: invalid character 'o' in literal false (expecting 'a')); no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/decrypt (Invalid Password)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | userPassword    | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_encrypt | header |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/decrypt" endpoint with the following form data and header(s):
+      | files    | teststore/page_1.pdf | file  |
+      | password | bar                  | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      At least one PDF engine cannot open a PDF with the given password, while others may have failed to decrypt due to different issues
+      """
+
+  Scenario: POST /forms/pdfengines/decrypt (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/decrypt" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Passwords)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | userPassword    | foo                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_encrypt | header |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | teststore/page_1.pdf     | file   |
+      | files                     | testdata/page_2.pdf      | file   |
+      | passwords                 | {"page_1.pdf":"foo"}     | field  |
+      | Gotenberg-Output-Filename | foo                      | header |
+      | Gotenberg-Trace           | forms_pdfengines_decrypt | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 2 page(s)
+    Then the response PDF(s) should NOT be encrypted
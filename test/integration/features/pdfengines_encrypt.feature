@@ -95,6 +95,78 @@ Feature: /forms/pdfengines/encrypt
     Then there should be 1 PDF(s) in the response
     Then the response PDF(s) should be encrypted
 
+  Scenario: POST /forms/pdfengines/encrypt (QPDF - permissions and algorithm)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ENCRYPT_ENGINES | qpdf |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | userPassword        | foo                 | field |
+      | ownerPassword       | bar                 | field |
+      | encryptionAlgorithm | aes-128             | field |
+      | allowPrint          | false               | field |
+      | allowCopy           | false               | field |
+      | allowModify         | false               | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be encrypted
+
+  Scenario: POST /forms/pdfengines/encrypt (QPDF - RC4 128-bit)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ENCRYPT_ENGINES | qpdf |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | userPassword        | foo                 | field |
+      | ownerPassword       | bar                 | field |
+      | encryptionAlgorithm | rc4-128             | field |
+      | allowModify         | false               | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be encrypted
+
+  Scenario: POST /forms/pdfengines/encrypt (QPDF - RC4 40-bit)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ENCRYPT_ENGINES | qpdf |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | userPassword        | foo                 | field |
+      | ownerPassword       | bar                 | field |
+      | encryptionAlgorithm | rc4-40              | field |
+      | allowCopy           | false               | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be encrypted
+
+  Scenario: POST /forms/pdfengines/encrypt (PDFtk - permissions and algorithm)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ENCRYPT_ENGINES | pdftk |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files                 | testdata/page_1.pdf | file  |
+      | userPassword          | foo                 | field |
+      | ownerPassword         | bar                 | field |
+      | encryptionAlgorithm   | rc4-40              | field |
+      | allowPrintHighQuality | false               | field |
+      | allowAnnotate         | false               | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should be encrypted
+
+  Scenario: POST /forms/pdfengines/encrypt (pdfcpu - unsupported permissions)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ENCRYPT_ENGINES | pdfcpu |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files        | testdata/page_1.pdf | file  |
+      | userPassword | foo                 | field |
+      | allowCopy    | false               | field |
+    Then the response status code should be 400
+    Then the response body should match string:
+      """
+      pdfcpu: only all, printing or no permissions are supported
+      """
+
   Scenario: POST /forms/pdfengines/encrypt (Many PDFs)
     Given I have a default Gotenberg container
     When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
@@ -115,6 +187,16 @@ Feature: /forms/pdfengines/encrypt
       """
       Invalid form data: form field 'userPassword' is required
       """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/encrypt" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | userPassword        | foo                 | field |
+      | encryptionAlgorithm | foo                 | field |
+      | allowPrint          | foo                 | field |
+    Then the response status code should be 400
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'encryptionAlgorithm' is invalid (got 'foo', resulting to wrong value, expected either 'aes-256', 'aes-128', 'rc4-128' or 'rc4-40'); form field 'allowPrint' is invalid (got 'foo', resulting to strconv.ParseBool: parsing "foo": invalid syntax)
+      """
 
   Scenario: POST /forms/pdfengines/encrypt (Routes Disabled)
     Given I have a Gotenberg container with the following environment variable(s):
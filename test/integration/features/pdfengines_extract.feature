@@ -0,0 +1,120 @@
+@pdfengines
+@pdfengines-extract
+@extract
+Feature: /forms/pdfengines/{embeds,images}
+
+  Scenario: POST /forms/pdfengines/embeds/list
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embed" endpoint with the following form data and header(s):
+      | files  | testdata/page_1.pdf  | file |
+      | embeds | testdata/embed_1.xml | file |
+      | embeds | testdata/embed_2.xml | file |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embeds/list" endpoint with the following form data and header(s):
+      | files | teststore/page_1.pdf | file |
+      | files | testdata/page_2.pdf  | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [
+          {
+            "name": "embed_1.xml",
+            "size": "ignore"
+          },
+          {
+            "name": "embed_2.xml",
+            "size": "ignore"
+          }
+        ],
+        "page_2.pdf": []
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/embeds/extract (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embed" endpoint with the following form data and header(s):
+      | files  | testdata/page_1.pdf  | file |
+      | embeds | testdata/embed_1.xml | file |
+      | embeds | testdata/embed_2.xml | file |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embeds/extract" endpoint with the following form data and header(s):
+      | files                     | teststore/page_1.pdf | file   |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip     |
+      | embed_1.xml |
+      | embed_2.xml |
+
+  Scenario: POST /forms/pdfengines/embeds/extract (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embed" endpoint with the following form data and header(s):
+      | files  | testdata/page_1.pdf  | file |
+      | files  | testdata/page_2.pdf  | file |
+      | embeds | testdata/embed_1.xml | file |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embeds/extract" endpoint with the following form data and header(s):
+      | files                     | teststore/page_1.pdf | file   |
+      | files                     | teststore/page_2.pdf | file   |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip            |
+      | page_1_embed_1.xml |
+      | page_2_embed_1.xml |
+
+  Scenario: POST /forms/pdfengines/embeds/extract (No Embedded Files)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embeds/extract" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      The PDF(s) do not contain embedded files
+      """
+
+  Scenario: POST /forms/pdfengines/images/extract
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/extract" endpoint with the following form data and header(s):
+      | files                     | testdata/images.pdf | file   |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip |
+      | *_.png  |
+
+  Scenario: POST /forms/pdfengines/images/extract (Page Ranges)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/extract" endpoint with the following form data and header(s):
+      | files      | testdata/images.pdf | file  |
+      | pageRanges | 2                   | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "image/png"
+
+  Scenario: POST /forms/pdfengines/images/extract (No Images)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/images/extract" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      The PDF(s) do not contain images
+      """
+
+  Scenario: POST /forms/pdfengines/embeds/list (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embeds/list" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
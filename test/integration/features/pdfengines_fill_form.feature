@@ -0,0 +1,101 @@
+@pdfengines
+@pdfengines-fill-form
+@fill-form
+Feature: /forms/pdfengines/forms/fill
+
+  Scenario: POST /forms/pdfengines/forms/fill (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files                     | testdata/form.pdf                                                          | file   |
+      | fields                    | {"name":"Jane Doe","subscribe":true,"address.city":"Paris","color":"Blue"} | field  |
+      | Gotenberg-Output-Filename | foo                                                                        | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the response PDF(s) should NOT be flatten
+
+  Scenario: POST /forms/pdfengines/forms/fill (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files  | testdata/form.pdf   | file  |
+      | files  | testdata/page_1.pdf | file  |
+      | fields | {"name":"Jane Doe"} | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | form.pdf   |
+      | page_1.pdf |
+
+  @flatten
+  Scenario: POST /forms/pdfengines/forms/fill (Flatten)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files                     | testdata/form.pdf                          | file   |
+      | fields                    | {"name":"Jane Doe","address.city":"Paris"} | field  |
+      | flatten                   | true                                       | field  |
+      | Gotenberg-Output-Filename | foo                                        | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 1 page(s)
+    Then the "foo.pdf" PDF should have the following content at page 1:
+      """
+      Jane Doe
+      """
+    Then the "foo.pdf" PDF should have the following content at page 1:
+      """
+      Paris
+      """
+    Then the response PDF(s) should be flatten
+
+  Scenario: POST /forms/pdfengines/forms/fill (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'fields' is required; no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files  | testdata/form.pdf | file  |
+      | fields | foo               | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'fields' is invalid (got 'foo', resulting to unmarshal fields: invalid character 'o' in literal false (expecting 'a'))
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files  | testdata/form.pdf      | file  |
+      | fields | {"name":{"foo":"bar"}} | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pdftk: field 'name': unsupported value 'map[foo:bar]'
+      """
+
+  Scenario: POST /forms/pdfengines/forms/fill (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files  | testdata/form.pdf   | file  |
+      | fields | {"name":"Jane Doe"} | field |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/forms/fill (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files           | testdata/form.pdf          | file   |
+      | fields          | {"name":"Jane Doe"}        | field  |
+      | Gotenberg-Trace | forms_pdfengines_fill_form | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_fill_form"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_fill_form" |
@@ -0,0 +1,98 @@
+@pdfengines
+@pdfengines-impose
+@impose
+Feature: /forms/pdfengines/impose
+
+  Scenario: POST /forms/pdfengines/impose (N-up)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf | file   |
+      | pagesPerSheet             | 4                     | field  |
+      | pageSize                  | A4                    | field  |
+      | orientation               | down-right            | field  |
+      | border                    | true                  | field  |
+      | margin                    | 10                    | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 3 page(s)
+
+  Scenario: POST /forms/pdfengines/impose (N-up Landscape)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf | file   |
+      | pagesPerSheet             | 2                     | field  |
+      | landscape                 | true                  | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 6 page(s)
+    Then the "foo.pdf" PDF should be set to landscape orientation
+
+  Scenario: POST /forms/pdfengines/impose (Booklet)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf | file   |
+      | mode                      | booklet               | field  |
+      | pagesPerSheet             | 2                     | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 6 page(s)
+
+  Scenario: POST /forms/pdfengines/impose (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf  | file   |
+      | files                     | testdata/pages_12.pdf | file   |
+      | pagesPerSheet             | 4                     | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.zip      |
+      | pages_3.pdf  |
+      | pages_12.pdf |
+    Then the "pages_3.pdf" PDF should have 1 page(s)
+    Then the "pages_12.pdf" PDF should have 3 page(s)
+
+  Scenario: POST /forms/pdfengines/impose (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | mode        | foo | field |
+      | pageSize    | foo | field |
+      | orientation | foo | field |
+      | margin      | -1  | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'mode' is invalid (got 'foo', resulting to wrong value, expected either 'nup' or 'booklet'); form field 'pagesPerSheet' is required; form field 'pageSize' is invalid (got 'foo', resulting to wrong value, expected an ISO A, B or C size (e.g., 'A4'), 'Letter', 'Legal', 'Ledger' or 'Tabloid'); form field 'orientation' is invalid (got 'foo', resulting to wrong value, expected either 'right-down', 'down-right', 'left-down' or 'down-left'); form field 'margin' is invalid (got '-1', resulting to value is inferior to 0); no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files         | testdata/pages_3.pdf | file  |
+      | mode          | booklet              | field |
+      | pagesPerSheet | 3                    | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pdfcpu: 3 pages per sheet is not supported with the 'booklet' mode
+      """
+
+  Scenario: POST /forms/pdfengines/impose (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/impose" endpoint with the following form data and header(s):
+      | files | testdata/pages_3.pdf | file |
+    Then the response status code should be 404
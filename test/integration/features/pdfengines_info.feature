@@ -0,0 +1,118 @@
+@pdfengines
+@pdfengines-info
+@info
+Feature: /forms/pdfengines/info
+
+  Scenario: POST /forms/pdfengines/info (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | testdata/pages_3.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "pages_3.pdf": {
+          "version": "1.7",
+          "pageCount": 3,
+          "pages": [
+            {
+              "number": 1,
+              "mediaBox": [0, 0, 446.25, 631.5],
+              "cropBox": [0, 0, 446.25, 631.5],
+              "width": 446.25,
+              "height": 631.5,
+              "rotation": 0
+            },
+            {
+              "number": 2,
+              "mediaBox": [0, 0, 446.25, 631.5],
+              "cropBox": [0, 0, 446.25, 631.5],
+              "width": 446.25,
+              "height": 631.5,
+              "rotation": 0
+            },
+            {
+              "number": 3,
+              "mediaBox": [0, 0, 446.25, 631.5],
+              "cropBox": [0, 0, 446.25, 631.5],
+              "width": 446.25,
+              "height": 631.5,
+              "rotation": 0
+            }
+          ],
+          "linearized": false,
+          "encrypted": false,
+          "permissions": "ignore",
+          "hasForm": false,
+          "hasSignatures": false,
+          "pdfa": "ignore",
+          "pdfua": "ignore"
+        }
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/info (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf   | file   |
+      | files           | testdata/page_2.pdf   | file   |
+      | rotateAngle     | 90                    | field  |
+      | Gotenberg-Trace | forms_pdfengines_info | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | teststore/page_1.pdf | file |
+      | files | teststore/page_2.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": {
+          "pageCount": 1,
+          "pages": [
+            {
+              "number": 1,
+              "mediaBox": "ignore",
+              "cropBox": "ignore",
+              "width": "ignore",
+              "height": "ignore",
+              "rotation": 90
+            }
+          ]
+        },
+        "page_2.pdf": {
+          "pageCount": 1,
+          "pages": [
+            {
+              "number": 1,
+              "mediaBox": "ignore",
+              "cropBox": "ignore",
+              "width": "ignore",
+              "height": "ignore",
+              "rotation": 90
+            }
+          ]
+        }
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/info (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/info (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
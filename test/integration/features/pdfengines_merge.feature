@@ -130,6 +130,28 @@ Feature: /forms/pdfengines/merge
       """
       Invalid form data: form field 'metadata' is invalid (got 'foo', resulting to unmarshal metadata: invalid character 'o' in literal false (expecting 'a'))
       """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files            | testdata/page_1.pdf | file  |
+      | files            | testdata/page_2.pdf | file  |
+      | bookmarksPerFile | true                | field |
+      | bookmarkLabels   | foo                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'bookmarkLabels' is invalid (got 'foo', resulting to unmarshal bookmark labels: invalid character 'o' in literal false (expecting 'a'))
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files        | testdata/page_1.pdf | file  |
+      | files        | testdata/page_2.pdf | file  |
+      | linearize    | true                | field |
+      | userPassword | foo                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'linearize' is invalid (got 'true', resulting to cannot linearize an encrypted or signed PDF, remove the 'userPassword' and 'sign' form fields)
+      """
 
   @convert
   Scenario: POST /forms/pdfengines/merge (PDF/A-1b & PDF/UA-1)
@@ -203,6 +225,54 @@ Feature: /forms/pdfengines/merge
       }
       """
 
+  @bookmarks
+  Scenario: POST /forms/pdfengines/merge (Bookmarks Per File)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/write" endpoint with the following form data and header(s):
+      | files           | testdata/pages_3.pdf                                            | file   |
+      | bookmarks       | [{"title":"Chapter 1","page":1},{"title":"Chapter 2","page":3}] | field  |
+      | Gotenberg-Trace | forms_pdfengines_bookmarks_write                                | header |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf    | file   |
+      | files                     | teststore/pages_3.pdf  | file   |
+      | bookmarksPerFile          | true                   | field  |
+      | bookmarkLabels            | {"page_1.pdf":"Cover"} | field  |
+      | Gotenberg-Output-Filename | foo                    | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 4 page(s)
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/bookmarks/read" endpoint with the following form data and header(s):
+      | files | teststore/foo.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "foo.pdf": [
+          {
+            "title": "Cover",
+            "page": 1
+          },
+          {
+            "title": "pages_3",
+            "page": 2,
+            "children": [
+              {
+                "title": "Chapter 1",
+                "page": 2
+              },
+              {
+                "title": "Chapter 2",
+                "page": 4
+              }
+            ]
+          }
+        ]
+      }
+      """
+
   @flatten
   Scenario: POST /forms/pdfengines/merge (Flatten)
     Given I have a default Gotenberg container
@@ -227,6 +297,68 @@ Feature: /forms/pdfengines/merge
       """
     Then the response PDF(s) should be flatten
 
+  @repair
+  Scenario: POST /forms/pdfengines/merge (Auto Repair)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/damaged_page_1.pdf | file   |
+      | files                     | testdata/page_2.pdf         | file   |
+      | autoRepair                | true                        | field  |
+      | Gotenberg-Output-Filename | foo                         | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Repaired-Files" should be "damaged_page_1.pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 2 page(s)
+    Then the "foo.pdf" PDF should have the following content at page 1:
+      """
+      Page 1
+      """
+    Then the "foo.pdf" PDF should have the following content at page 2:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/merge (Normalize Paper Size)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf | file   |
+      | files                     | testdata/page_2.pdf | file   |
+      | normalizePaperSize        | true                | field  |
+      | paperWidth                | 210mm               | field  |
+      | paperHeight               | 297mm               | field  |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 2 page(s)
+    Then the "foo.pdf" PDF should have its page 1 "MediaBox" at "0 0 595 842"
+    Then the "foo.pdf" PDF should have its page 2 "MediaBox" at "0 0 595 842"
+
+  @optimize
+  Scenario: POST /forms/pdfengines/merge (Linearize & Metadata)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf | file   |
+      | files                     | testdata/page_2.pdf | file   |
+      | linearize                 | true                | field  |
+      | metadata                  | {"Author":"foo"}    | field  |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | teststore/foo.pdf | file |
+    Then the response status code should be 200
+    Then the response body should match JSON:
+      """
+      {
+        "foo.pdf": {
+          "linearized": true
+        }
+      }
+      """
+
   @encrypt
   Scenario: POST /forms/pdfengines/merge (Encrypt - user password only)
     Given I have a default Gotenberg container
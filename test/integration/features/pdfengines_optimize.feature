@@ -0,0 +1,115 @@
+@pdfengines
+@pdfengines-optimize
+@optimize
+Feature: /forms/pdfengines/optimize
+
+  Scenario: POST /forms/pdfengines/optimize (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files           | testdata/pages_12.pdf     | file   |
+      | compressImages  | true                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_optimize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | pages_12.pdf |
+
+  Scenario: POST /forms/pdfengines/optimize (Linearize)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf       | file   |
+      | linearize       | true                      | field  |
+      | Gotenberg-Trace | forms_pdfengines_optimize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | teststore/page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": {
+          "linearized": true
+        }
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/optimize (Remove Duplicates)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files            | testdata/page_1.pdf       | file   |
+      | removeDuplicates | true                      | field  |
+      | Gotenberg-Trace  | forms_pdfengines_optimize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+
+  Scenario: POST /forms/pdfengines/optimize (Remove Duplicates & Compress Images & Linearize)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files            | testdata/pages_12.pdf     | file   |
+      | removeDuplicates | true                      | field  |
+      | compressImages   | true                      | field  |
+      | linearize        | true                      | field  |
+      | Gotenberg-Trace  | forms_pdfengines_optimize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | teststore/pages_12.pdf | file |
+    Then the response status code should be 200
+    Then the response body should match JSON:
+      """
+      {
+        "pages_12.pdf": {
+          "linearized": true
+        }
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/optimize (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+      | files | testdata/page_2.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+      | page_2.pdf |
+
+  Scenario: POST /forms/pdfengines/optimize (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | compress                  | foo | field  |
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'compress' is invalid (got 'foo', resulting to strconv.ParseBool: parsing "foo": invalid syntax); no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/optimize (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/optimize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Compress)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf       | file   |
+      | files                     | testdata/page_2.pdf       | file   |
+      | compress                  | true                      | field  |
+      | Gotenberg-Output-Filename | foo                       | header |
+      | Gotenberg-Trace           | forms_pdfengines_optimize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have 2 page(s)
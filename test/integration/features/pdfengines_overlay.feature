@@ -0,0 +1,97 @@
+@pdfengines
+@pdfengines-overlay
+@overlay
+Feature: /forms/pdfengines/overlay
+
+  Scenario: POST /forms/pdfengines/overlay (Overlay)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | overlay         | testdata/page_2.pdf      | file   |
+      | Gotenberg-Trace | forms_pdfengines_overlay | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have the following content at page 1:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/overlay (Underlay - First)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | files           | testdata/pages_3.pdf     | file   |
+      | underlay        | testdata/page_2.pdf      | file   |
+      | underlayMode    | first                    | field  |
+      | Gotenberg-Trace | forms_pdfengines_overlay | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "pages_3.pdf" PDF should have the following content at page 1:
+      """
+      Page 2
+      """
+    Then the "pages_3.pdf" PDF should NOT have the following content at page 3:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/overlay (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file |
+      | files   | testdata/page_2.pdf | file |
+      | overlay | testdata/page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+      | page_2.pdf |
+
+  Scenario: POST /forms/pdfengines/overlay (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'overlayMode' is invalid (got '', resulting to either an overlay or an underlay file is required); no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | overlay     | testdata/page_2.pdf | file  |
+      | overlayMode | foo                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'overlayMode' is invalid (got 'foo', resulting to wrong value, expected either 'first', 'sequence' or 'repeat')
+      """
+
+  Scenario: POST /forms/pdfengines/overlay (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/overlay" endpoint with the following form data and header(s):
+      | files   | testdata/page_1.pdf | file |
+      | overlay | testdata/page_2.pdf | file |
+    Then the response status code should be 404
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Overlay)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf      | file   |
+      | files                     | testdata/page_2.pdf      | file   |
+      | overlay                   | testdata/page_2.pdf      | file   |
+      | overlayMode               | first                    | field  |
+      | Gotenberg-Output-Filename | foo                      | header |
+      | Gotenberg-Trace           | forms_pdfengines_overlay | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "foo.pdf" PDF should have the following content at page 1:
+      """
+      Page 2
+      """
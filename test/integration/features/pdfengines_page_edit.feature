@@ -0,0 +1,124 @@
+@pdfengines
+@pdfengines-page-edit
+@page-edit
+Feature: /forms/pdfengines/pages
+
+  Scenario: POST /forms/pdfengines/pages (Delete, Move & Duplicate)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf                                                                                        | file   |
+      | instructions              | [{"op":"delete","pages":"3-5"},{"op":"move","pages":"7","after":1},{"op":"duplicate","pages":"1","count":2}] | field  |
+      | Gotenberg-Output-Filename | foo                                                                                                          | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 11 page(s)
+    Then the "foo.pdf" PDF should have the following content at page 1:
+      """
+      Page 1
+      """
+    Then the "foo.pdf" PDF should have the following content at page 3:
+      """
+      Page 1
+      """
+    Then the "foo.pdf" PDF should have the following content at page 4:
+      """
+      Page 10
+      """
+    Then the "foo.pdf" PDF should have the following content at page 5:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/pages (Keep)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf            | file   |
+      | instructions              | [{"op":"keep","pages":"2, 10-"}] | field  |
+      | Gotenberg-Output-Filename | foo                              | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 4 page(s)
+    Then the "foo.pdf" PDF should have the following content at page 2:
+      """
+      Page 10
+      """
+
+  Scenario: POST /forms/pdfengines/pages (Insert Blank Pages)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf                                                                          | file   |
+      | instructions              | [{"op":"delete","pages":"3"},{"op":"insert-blank","after":1},{"op":"insert-blank","count":2}] | field  |
+      | Gotenberg-Output-Filename | foo                                                                                           | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 5 page(s)
+    Then the "foo.pdf" PDF should have the following content at page 3:
+      """
+      Page 1
+      """
+    Then the "foo.pdf" PDF should have the following content at page 5:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/pages (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf          | file   |
+      | files                     | testdata/pages_12.pdf         | file   |
+      | instructions              | [{"op":"delete","pages":"1"}] | field  |
+      | Gotenberg-Output-Filename | foo                           | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.zip      |
+      | pages_3.pdf  |
+      | pages_12.pdf |
+    Then the "pages_3.pdf" PDF should have 2 page(s)
+    Then the "pages_12.pdf" PDF should have 11 page(s)
+
+  Scenario: POST /forms/pdfengines/pages (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'instructions' is required; no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files        | testdata/pages_3.pdf       | file  |
+      | instructions | [{"op":"foo","pages":"1"}] | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'instructions' is invalid (got '[{"op":"foo","pages":"1"}]', resulting to instruction 1: wrong operation 'foo', expected either 'keep', 'delete', 'insert-blank', 'move' or 'duplicate')
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files        | testdata/pages_3.pdf           | file  |
+      | instructions | [{"op":"delete","pages":"1-"}] | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      qpdf: delete: no pages left
+      """
+
+  Scenario: POST /forms/pdfengines/pages (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/pages" endpoint with the following form data and header(s):
+      | files | testdata/pages_3.pdf | file |
+    Then the response status code should be 404
@@ -0,0 +1,90 @@
+@pdfengines
+@pdfengines-rasterize
+@rasterize
+Feature: /forms/pdfengines/rasterize
+
+  Scenario: POST /forms/pdfengines/rasterize (Single Page)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf | file   |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "image/png"
+    Then there should be the following file(s) in the response:
+      | foo.png |
+
+  Scenario: POST /forms/pdfengines/rasterize (Many Pages)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf | file   |
+      | format                    | jpeg                 | field  |
+      | quality                   | 50                   | field  |
+      | dpi                       | 72                   | field  |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip |
+      | 1.jpg   |
+      | 2.jpg   |
+      | 3.jpg   |
+
+  Scenario: POST /forms/pdfengines/rasterize (Page Ranges & WebP)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_12.pdf | file   |
+      | format                    | webp                  | field  |
+      | pageRanges                | 1-2, 10-              | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip |
+      | 1.webp  |
+      | 2.webp  |
+      | 10.webp |
+      | 11.webp |
+      | 12.webp |
+
+  Scenario: POST /forms/pdfengines/rasterize (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf | file   |
+      | files                     | testdata/page_2.pdf | file   |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip      |
+      | page_1_1.png |
+      | page_2_1.png |
+
+  Scenario: POST /forms/pdfengines/rasterize (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | format                    | gif | field  |
+      | dpi                       | foo | field  |
+      | quality                   | 101 | field  |
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'format' is invalid (got 'gif', resulting to wrong value, expected either 'png', 'jpeg' or 'webp'); form field 'dpi' is invalid (got 'foo', resulting to strconv.Atoi: parsing "foo": invalid syntax); form field 'quality' is invalid (got '101', resulting to value must be between 1 and 100); no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files      | testdata/page_1.pdf | file  |
+      | pageRanges | 2-1                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pdftoppm: invalid page range '2-1'
+      """
+
+  Scenario: POST /forms/pdfengines/rasterize (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rasterize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
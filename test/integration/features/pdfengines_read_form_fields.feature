@@ -0,0 +1,131 @@
+@pdfengines
+@pdfengines-read-form-fields
+@read-form-fields
+Feature: /forms/pdfengines/forms/read
+
+  Scenario: POST /forms/pdfengines/forms/read (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | files | testdata/form.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "form.pdf": [
+          {
+            "name": "name",
+            "type": "text",
+            "value": "",
+            "readOnly": false,
+            "required": false,
+            "page": 1,
+            "rect": [150, 490, 400, 515]
+          },
+          {
+            "name": "subscribe",
+            "type": "checkbox",
+            "value": false,
+            "options": ["Yes"],
+            "page": 1,
+            "rect": [150, 455, 168, 473]
+          },
+          {
+            "name": "address.city",
+            "type": "text",
+            "value": "",
+            "page": 1,
+            "rect": [150, 410, 400, 435]
+          },
+          {
+            "name": "color",
+            "type": "choice",
+            "value": "Red",
+            "options": ["Red", "Green", "Blue"],
+            "page": 1,
+            "rect": [150, 370, 400, 395]
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/forms/read (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | files | testdata/form.pdf   | file |
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "form.pdf": "ignore",
+        "page_1.pdf": []
+      }
+      """
+
+  @fill-form
+  Scenario: POST /forms/pdfengines/forms/{fill|read}
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/fill" endpoint with the following form data and header(s):
+      | files                     | testdata/form.pdf                                                          | file   |
+      | fields                    | {"name":"Jane Doe","subscribe":true,"address.city":"Paris","color":"Blue"} | field  |
+      | Gotenberg-Output-Filename | foo                                                                        | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | files | teststore/foo.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "foo.pdf": [
+          {
+            "name": "name",
+            "value": "Jane Doe"
+          },
+          {
+            "name": "subscribe",
+            "value": true
+          },
+          {
+            "name": "address.city",
+            "value": "Paris"
+          },
+          {
+            "name": "color",
+            "value": "Blue"
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/forms/read (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/forms/read (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | files | testdata/form.pdf | file |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/forms/read (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/forms/read" endpoint with the following form data and header(s):
+      | files           | testdata/form.pdf                 | file   |
+      | Gotenberg-Trace | forms_pdfengines_read_form_fields | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_read_form_fields"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_read_form_fields" |
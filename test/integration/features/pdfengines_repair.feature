@@ -0,0 +1,62 @@
+@pdfengines
+@pdfengines-repair
+@repair
+Feature: /forms/pdfengines/repair
+
+  Scenario: POST /forms/pdfengines/repair (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | files | testdata/damaged_page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "damaged_page_1.pdf" PDF should have 1 page(s)
+
+  Scenario: POST /forms/pdfengines/repair (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | files | testdata/damaged_page_1.pdf | file |
+      | files | testdata/page_2.pdf         | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then the "damaged_page_1.pdf" PDF should have 1 page(s)
+    Then the "page_2.pdf" PDF should have 1 page(s)
+
+  Scenario: POST /forms/pdfengines/repair (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/repair (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | files | testdata/damaged_page_1.pdf | file |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/repair (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | files           | testdata/damaged_page_1.pdf | file   |
+      | Gotenberg-Trace | forms_pdfengines_repair     | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_repair"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_repair" |
+
+  Scenario: POST /forms/pdfengines/repair (Basic Auth)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | API_ENABLE_BASIC_AUTH             | true |
+      | GOTENBERG_API_BASIC_AUTH_USERNAME | foo  |
+      | GOTENBERG_API_BASIC_AUTH_PASSWORD | bar  |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/repair" endpoint with the following form data and header(s):
+      | files | testdata/damaged_page_1.pdf | file |
+    Then the response status code should be 401
@@ -0,0 +1,94 @@
+@pdfengines
+@pdfengines-resize
+@resize
+Feature: /forms/pdfengines/resize
+
+  Scenario: POST /forms/pdfengines/resize (Paper Size)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | paperWidth  | 210mm               | field |
+      | paperHeight | 297mm               | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have its page 1 "MediaBox" at "0 0 595 842"
+
+  Scenario: POST /forms/pdfengines/resize (Scale)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | files | testdata/page_2.pdf | file  |
+      | scale | 2                   | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have 1 page(s)
+    Then the "page_2.pdf" PDF should have 1 page(s)
+
+  Scenario: POST /forms/pdfengines/resize (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | scale | 2 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: either 'paperWidth' and 'paperHeight' or 'scale' form fields must be provided
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files      | testdata/page_1.pdf | file  |
+      | paperWidth | 210mm               | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'paperHeight' is invalid (got '', resulting to wrong value, expected both 'paperWidth' and 'paperHeight' form fields)
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | scale | -1                  | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'scale' is invalid (got '-1', resulting to value is inferior or equal to 0)
+      """
+
+  Scenario: POST /forms/pdfengines/resize (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | scale | 2                   | field |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/resize (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf     | file   |
+      | scale           | 2                       | field  |
+      | Gotenberg-Trace | forms_pdfengines_resize | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_resize"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_resize" |
+
+  Scenario: POST /forms/pdfengines/resize (Basic Auth)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | API_ENABLE_BASIC_AUTH             | true |
+      | GOTENBERG_API_BASIC_AUTH_USERNAME | foo  |
+      | GOTENBERG_API_BASIC_AUTH_PASSWORD | bar  |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/resize" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | scale | 2                   | field |
+    Then the response status code should be 401
@@ -0,0 +1,137 @@
+@pdfengines
+@pdfengines-rotate
+@rotate
+Feature: /forms/pdfengines/rotate
+
+  Scenario: POST /forms/pdfengines/rotate (Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf      | file   |
+      | rotateAngle     | 90                       | field  |
+      | Gotenberg-Trace | forms_pdfengines_rotate  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have its page 1 rotated by 90 degree(s)
+
+  Scenario: POST /forms/pdfengines/rotate (Pages)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files           | testdata/pages_3.pdf    | file   |
+      | rotateAngle     | 180                     | field  |
+      | rotatePages     | 2                       | field  |
+      | Gotenberg-Trace | forms_pdfengines_rotate | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "pages_3.pdf" PDF should have its page 1 rotated by 0 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 2 rotated by 180 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 3 rotated by 0 degree(s)
+
+  Scenario: POST /forms/pdfengines/rotate (Pages - QPDF)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ROTATE_ENGINES | qpdf |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/pages_3.pdf | file  |
+      | rotateAngle | 90                   | field |
+      | rotatePages | 1, 3-                | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "pages_3.pdf" PDF should have its page 1 rotated by 90 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 2 rotated by 0 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 3 rotated by 90 degree(s)
+
+  Scenario: POST /forms/pdfengines/rotate (Pages - pdfcpu)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ROTATE_ENGINES | pdfcpu |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/pages_3.pdf | file  |
+      | rotateAngle | 90                   | field |
+      | rotatePages | 1, 3-                | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "pages_3.pdf" PDF should have its page 1 rotated by 90 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 2 rotated by 0 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 3 rotated by 90 degree(s)
+
+  Scenario: POST /forms/pdfengines/rotate (Pages - PDFtk)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_ROTATE_ENGINES | pdftk |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/pages_3.pdf | file  |
+      | rotateAngle | 90                   | field |
+      | rotatePages | 1, 3-                | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "pages_3.pdf" PDF should have its page 1 rotated by 90 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 2 rotated by 0 degree(s)
+    Then the "pages_3.pdf" PDF should have its page 3 rotated by 90 degree(s)
+
+  Scenario: POST /forms/pdfengines/rotate (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | files       | testdata/page_2.pdf | file  |
+      | rotateAngle | 270                 | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+      | page_2.pdf |
+
+  Scenario: POST /forms/pdfengines/rotate (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'rotateAngle' is required; no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | rotateAngle | 45                  | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'rotateAngle' is invalid (got '45', resulting to wrong value, expected either 90, 180 or 270)
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | rotateAngle | 90                  | field |
+      | rotatePages | 2-end               | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'rotatePages' is invalid (got '2-end', resulting to invalid page range '2-end')
+      """
+
+  Scenario: POST /forms/pdfengines/rotate (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/rotate" endpoint with the following form data and header(s):
+      | files       | testdata/page_1.pdf | file  |
+      | rotateAngle | 90                  | field |
+    Then the response status code should be 404
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Rotate)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf     | file   |
+      | files                     | testdata/page_2.pdf     | file   |
+      | rotateAngle               | 90                      | field  |
+      | rotatePages               | 2                       | field  |
+      | Gotenberg-Output-Filename | foo                     | header |
+      | Gotenberg-Trace           | forms_pdfengines_rotate | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the "foo.pdf" PDF should have its page 1 rotated by 0 degree(s)
+    Then the "foo.pdf" PDF should have its page 2 rotated by 90 degree(s)
@@ -0,0 +1,115 @@
+@pdfengines
+@pdfengines-sanitize
+@sanitize
+Feature: /forms/pdfengines/sanitize
+
+  Scenario: POST /forms/pdfengines/sanitize (Embedded Files)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embed" endpoint with the following form data and header(s):
+      | files  | testdata/page_1.pdf  | file |
+      | embeds | testdata/embed_1.xml | file |
+    Then the response status code should be 200
+    Then the response PDF(s) should have the "embed_1.xml" file embedded
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | files    | teststore/page_1.pdf | file  |
+      | sanitize | embeddedFiles        | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+    Then the response PDF(s) should NOT have the "embed_1.xml" file embedded
+
+  Scenario: POST /forms/pdfengines/sanitize (Annotations)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | files    | testdata/form.pdf       | file  |
+      | sanitize | javascript, annotations | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/info" endpoint with the following form data and header(s):
+      | files | teststore/form.pdf | file |
+    Then the response status code should be 200
+    Then the response body should match JSON:
+      """
+      {
+        "form.pdf": {
+          "pageCount": 1,
+          "hasForm": false
+        }
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/sanitize (All)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf | file   |
+      | files                     | testdata/page_2.pdf | file   |
+      | sanitize                  | all                 | field  |
+      | Gotenberg-Output-Filename | foo                 | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.zip    |
+      | page_1.pdf |
+      | page_2.pdf |
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Sanitize)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/embed" endpoint with the following form data and header(s):
+      | files  | testdata/page_1.pdf  | file |
+      | embeds | testdata/embed_1.xml | file |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files    | teststore/page_1.pdf | file  |
+      | files    | testdata/page_2.pdf  | file  |
+      | sanitize | embeddedFiles        | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the response PDF(s) should NOT have the "embed_1.xml" file embedded
+
+  @split
+  Scenario: POST /forms/pdfengines/split (Sanitize)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/split" endpoint with the following form data and header(s):
+      | files     | testdata/form.pdf | file  |
+      | splitMode | pages             | field |
+      | splitSpan | 1                 | field |
+      | sanitize  | annotations       | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | form_0.pdf |
+
+  Scenario: POST /forms/pdfengines/sanitize (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'sanitize' is required; no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | files    | testdata/page_1.pdf | file  |
+      | sanitize | javascript,foo      | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'sanitize' is invalid (got 'javascript,foo', resulting to wrong category 'foo', expected 'all', 'javascript', 'launchActions', 'embeddedFiles', 'xfa' or 'annotations')
+      """
+
+  Scenario: POST /forms/pdfengines/sanitize (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sanitize" endpoint with the following form data and header(s):
+      | files    | testdata/page_1.pdf | file  |
+      | sanitize | all                 | field |
+    Then the response status code should be 404
@@ -0,0 +1,112 @@
+@pdfengines
+@pdfengines-sign
+@sign
+Feature: /forms/pdfengines/sign
+
+  Scenario: POST /forms/pdfengines/sign (Uploaded Keys)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PYHANKO_ALLOW_UPLOAD_KEYS | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf   | file  |
+      | signatureKeys   | testdata/pem/key.pem  | file  |
+      | signatureKeys   | testdata/pem/cert.pem | file  |
+      | signatureReason | Approved              | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+
+  Scenario: POST /forms/pdfengines/sign (Visible Signature)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PYHANKO_ALLOW_UPLOAD_KEYS | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf   | file   |
+      | files                     | testdata/page_2.pdf   | file   |
+      | signatureKeys             | testdata/pem/key.pem  | file   |
+      | signatureKeys             | testdata/pem/cert.pem | file   |
+      | signatureFieldName        | Approval              | field  |
+      | signatureRect             | 50,50,250,100         | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.zip    |
+      | page_1.pdf |
+      | page_2.pdf |
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Sign)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PYHANKO_ALLOW_UPLOAD_KEYS | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf   | file   |
+      | files                     | testdata/page_2.pdf   | file   |
+      | signatureKeys             | testdata/pem/key.pem  | file   |
+      | signatureKeys             | testdata/pem/cert.pem | file   |
+      | sign                      | true                  | field  |
+      | Gotenberg-Output-Filename | foo                   | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | foo.pdf |
+    Then the "foo.pdf" PDF should have 2 page(s)
+
+  @merge
+  @encrypt
+  Scenario: POST /forms/pdfengines/merge (Sign & Encrypt)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PYHANKO_ALLOW_UPLOAD_KEYS | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files         | testdata/page_1.pdf   | file  |
+      | files         | testdata/page_2.pdf   | file  |
+      | signatureKeys | testdata/pem/key.pem  | file  |
+      | signatureKeys | testdata/pem/cert.pem | file  |
+      | sign          | true                  | field |
+      | userPassword  | foo                   | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'sign' is invalid (got 'true', resulting to cannot sign an encrypted PDF, remove the 'userPassword' form field)
+      """
+
+  Scenario: POST /forms/pdfengines/sign (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | signaturePage         | 0         | field |
+      | signatureRect         | 10,10,5,5 | field |
+      | signatureTimestampUrl | ftp://foo | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'signaturePage' is invalid (got '0', resulting to value must be greater than 0); form field 'signatureRect' is invalid (got '10,10,5,5', resulting to wrong value, expected the lower left corner (x1,y1) before the upper right corner (x2,y2)); form field 'signatureTimestampUrl' is invalid (got 'ftp://foo', resulting to wrong value, expected an HTTP or HTTPS URL); no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pyHanko: no signing keys configured
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files         | testdata/page_1.pdf   | file |
+      | signatureKeys | testdata/pem/key.pem  | file |
+      | signatureKeys | testdata/pem/cert.pem | file |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pyHanko: uploading signing keys is not allowed
+      """
+
+  Scenario: POST /forms/pdfengines/sign (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
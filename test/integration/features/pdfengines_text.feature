@@ -0,0 +1,134 @@
+@pdfengines
+@pdfengines-text
+@text
+Feature: /forms/pdfengines/text
+
+  Scenario: POST /forms/pdfengines/text (JSON)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files | testdata/pages_3.pdf | file |
+      | files | testdata/page_1.pdf  | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "pages_3.pdf": [
+          {
+            "page": 1,
+            "text": "ignore"
+          },
+          {
+            "page": 2,
+            "text": "ignore"
+          },
+          {
+            "page": 3,
+            "text": "ignore"
+          }
+        ],
+        "page_1.pdf": [
+          {
+            "page": 1,
+            "text": "ignore"
+          }
+        ]
+      }
+      """
+    Then the response body should contain string:
+      """
+      Page 3
+      """
+
+  Scenario: POST /forms/pdfengines/text (JSON - Page Ranges)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files      | testdata/pages_3.pdf | file  |
+      | pageRanges | 2-3                  | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "pages_3.pdf": [
+          {
+            "page": 2,
+            "text": "ignore"
+          },
+          {
+            "page": 3,
+            "text": "ignore"
+          }
+        ]
+      }
+      """
+    Then the response body should contain string:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/text (Text - Single PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf | file   |
+      | format                    | txt                  | field  |
+      | pageRanges                | 2                    | field  |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "text/plain; charset=utf-8"
+    Then the response body should contain string:
+      """
+      Page 2
+      """
+
+  Scenario: POST /forms/pdfengines/text (Text - Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files                     | testdata/pages_3.pdf | file   |
+      | files                     | testdata/page_1.pdf  | file   |
+      | format                    | txt                  | field  |
+      | Gotenberg-Output-Filename | foo                  | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be the following file(s) in the response:
+      | foo.zip     |
+      | pages_3.txt |
+      | page_1.txt  |
+
+  Scenario: POST /forms/pdfengines/text (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | format | foo | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]; form field 'format' is invalid (got 'foo', resulting to wrong value, expected either 'json' or 'txt')
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files      | testdata/pages_3.pdf | file  |
+      | pageRanges | 2-5                  | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      pdftotext: page range '2-5' exceeds page count 3
+      """
+
+  Scenario: POST /forms/pdfengines/text (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
+
+  Scenario: POST /forms/pdfengines/text (Gotenberg Trace)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/text" endpoint with the following form data and header(s):
+      | files           | testdata/page_1.pdf   | file   |
+      | Gotenberg-Trace | forms_pdfengines_text | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response header "Gotenberg-Trace" should be "forms_pdfengines_text"
+    Then the Gotenberg container should log the following entries:
+      | "trace":"forms_pdfengines_text" |
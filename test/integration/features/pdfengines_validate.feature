@@ -0,0 +1,110 @@
+@pdfengines
+@pdfengines-validate
+@validate
+Feature: /forms/pdfengines/validate
+
+  Scenario: POST /forms/pdfengines/validate (PDF/A-1b)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/validate" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | files | testdata/page_2.pdf | file  |
+      | pdfa  | PDF/A-1b            | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [
+          {
+            "profile": "PDF/A-1B validation profile",
+            "compliant": false,
+            "violations": "ignore"
+          }
+        ],
+        "page_2.pdf": [
+          {
+            "profile": "PDF/A-1B validation profile",
+            "compliant": false,
+            "violations": "ignore"
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/validate (PDF/A-2b & PDF/UA-1)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/validate" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | pdfa  | PDF/A-2b            | field |
+      | pdfua | true                | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [
+          {
+            "profile": "PDF/A-2B validation profile",
+            "compliant": false,
+            "violations": "ignore"
+          },
+          {
+            "profile": "PDF/UA-1 validation profile",
+            "compliant": false,
+            "violations": "ignore"
+          }
+        ]
+      }
+      """
+
+  @convert
+  Scenario: POST /forms/pdfengines/convert (Fail On Non Compliance)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/convert" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | pdfua               | true                | field |
+      | failOnNonCompliance | true                | field |
+    Then the response status code should be 422
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should contain string:
+      """
+      The PDF does not conform to the 'PDF/UA-1 validation profile'
+      """
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Fail On Non Compliance Without PDF Formats)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf | file  |
+      | files               | testdata/page_2.pdf | file  |
+      | failOnNonCompliance | true                | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+
+  Scenario: POST /forms/pdfengines/validate (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/validate" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/validate" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file  |
+      | pdfa  | PDF/A-4             | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      At least one PDF engine cannot process the requested PDF format, while others may have failed to convert due to different issues
+      """
+
+  Scenario: POST /forms/pdfengines/validate (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/validate" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
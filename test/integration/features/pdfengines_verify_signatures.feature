@@ -0,0 +1,75 @@
+@pdfengines
+@pdfengines-verify-signatures
+@verify-signatures
+Feature: /forms/pdfengines/signatures/verify
+
+  Scenario: POST /forms/pdfengines/signatures/verify (Unsigned)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/signatures/verify" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+      | files | testdata/page_2.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [],
+        "page_2.pdf": []
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/signatures/verify (Signed)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PYHANKO_ALLOW_UPLOAD_KEYS | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/sign" endpoint with the following form data and header(s):
+      | files              | testdata/page_1.pdf   | file  |
+      | signatureKeys      | testdata/pem/key.pem  | file  |
+      | signatureKeys      | testdata/pem/cert.pem | file  |
+      | signatureFieldName | Approval              | field |
+    Then the response status code should be 200
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/signatures/verify" endpoint with the following form data and header(s):
+      | files | teststore/page_1.pdf | file |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/json"
+    Then the response body should match JSON:
+      """
+      {
+        "page_1.pdf": [
+          {
+            "fieldName": "Approval",
+            "certificates": [
+              {
+                "subject": "Common Name: localhost",
+                "issuer": "Common Name: localhost",
+                "serialNumber": "ignore",
+                "notBefore": "ignore",
+                "notAfter": "ignore"
+              }
+            ],
+            "signingTime": "ignore",
+            "intact": true,
+            "coversWholeDocument": true,
+            "modifiedAfterSigning": false,
+            "trusted": false
+          }
+        ]
+      }
+      """
+
+  Scenario: POST /forms/pdfengines/signatures/verify (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/signatures/verify" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: no form file found for extensions: [.pdf]
+      """
+
+  Scenario: POST /forms/pdfengines/signatures/verify (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/signatures/verify" endpoint with the following form data and header(s):
+      | files | testdata/page_1.pdf | file |
+    Then the response status code should be 404
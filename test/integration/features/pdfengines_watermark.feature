@@ -0,0 +1,93 @@
+@pdfengines
+@pdfengines-watermark
+@watermark
+Feature: /forms/pdfengines/watermark
+
+  Scenario: POST /forms/pdfengines/watermark (Text)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | files             | testdata/page_1.pdf        | file   |
+      | watermarkText     | CONFIDENTIAL               | field  |
+      | watermarkOpacity  | 0.5                        | field  |
+      | watermarkRotation | 45                         | field  |
+      | Gotenberg-Trace   | forms_pdfengines_watermark | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then the "page_1.pdf" PDF should have the following content at page 1:
+      """
+      CONFIDENTIAL
+      """
+
+  Scenario: POST /forms/pdfengines/watermark (PDF)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | files               | testdata/page_1.pdf        | file   |
+      | watermark           | testdata/page_2.pdf        | file   |
+      | watermarkForeground | true                       | field  |
+      | Gotenberg-Trace     | forms_pdfengines_watermark | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then there should be 1 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+
+  Scenario: POST /forms/pdfengines/watermark (Many PDFs)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | files          | testdata/page_1.pdf | file  |
+      | files          | testdata/page_2.pdf | file  |
+      | watermarkText  | DRAFT               | field |
+      | watermarkPages | 1                   | field |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/zip"
+    Then there should be 2 PDF(s) in the response
+    Then there should be the following file(s) in the response:
+      | page_1.pdf |
+      | page_2.pdf |
+
+  Scenario: POST /forms/pdfengines/watermark (Bad Request)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | Gotenberg-Output-Filename | foo | header |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'watermarkText' is invalid (got '', resulting to either a text or a watermark file is required); no form file found for extensions: [.pdf]
+      """
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | files             | testdata/page_1.pdf | file  |
+      | watermarkText     | DRAFT               | field |
+      | watermarkOpacity  | 2                   | field |
+      | watermarkPosition | foo                 | field |
+    Then the response status code should be 400
+    Then the response header "Content-Type" should be "text/plain; charset=UTF-8"
+    Then the response body should match string:
+      """
+      Invalid form data: form field 'watermarkOpacity' is invalid (got '2', resulting to value is superior to 1); form field 'watermarkPosition' is invalid (got 'foo', resulting to wrong value, expected either 'center', 'top-left', 'top-center', 'top-right', 'left', 'right', 'bottom-left', 'bottom-center' or 'bottom-right')
+      """
+
+  Scenario: POST /forms/pdfengines/watermark (Routes Disabled)
+    Given I have a Gotenberg container with the following environment variable(s):
+      | PDFENGINES_DISABLE_ROUTES | true |
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/watermark" endpoint with the following form data and header(s):
+      | files         | testdata/page_1.pdf | file  |
+      | watermarkText | DRAFT               | field |
+    Then the response status code should be 404
+
+  @merge
+  Scenario: POST /forms/pdfengines/merge (Watermark)
+    Given I have a default Gotenberg container
+    When I make a "POST" request to Gotenberg at the "/forms/pdfengines/merge" endpoint with the following form data and header(s):
+      | files                     | testdata/page_1.pdf        | file   |
+      | files                     | testdata/page_2.pdf        | file   |
+      | watermarkText             | DRAFT                      | field  |
+      | Gotenberg-Output-Filename | foo                        | header |
+      | Gotenberg-Trace           | forms_pdfengines_watermark | header |
+    Then the response status code should be 200
+    Then the response header "Content-Type" should be "application/pdf"
+    Then the "foo.pdf" PDF should have the following content at page 2:
+      """
+      DRAFT
+      """
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"mime"
 	"net/http"
 	"net/http/httptest"
@@ -566,12 +567,26 @@ func (s *scenario) thePdfsShouldBeValidWithAToleranceOf(ctx context.Context, kin
 
 	var flavor string
 	switch validate {
+	case "PDF/A-1a":
+		flavor = "1a"
 	case "PDF/A-1b":
 		flavor = "1b"
+	case "PDF/A-2a":
+		flavor = "2a"
 	case "PDF/A-2b":
 		flavor = "2b"
+	case "PDF/A-2u":
+		flavor = "2u"
+	case "PDF/A-3a":
+		flavor = "3a"
 	case "PDF/A-3b":
 		flavor = "3b"
+	case "PDF/A-3u":
+		flavor = "3u"
+	case "PDF/A-4":
+		flavor = "4"
+	case "PDF/A-4f":
+		flavor = "4f"
 	case "PDF/UA-1":
 		flavor = "ua1"
 	case "PDF/UA-2":
@@ -735,6 +750,132 @@ func (s *scenario) thePdfShouldBeSetToLandscapeOrientation(ctx context.Context,
 	return nil
 }
 
+func (s *scenario) thePdfShouldHavePageRotatedBy(ctx context.Context, name string, page, angle int) error {
+	var path string
+	if !strings.HasPrefix(name, "*_") {
+		path = fmt.Sprintf("%s/%s/%s", s.workdir, s.resp.Header().Get("Gotenberg-Trace"), name)
+
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("PDF %q does not exist", path)
+		}
+	} else {
+		substr := strings.ReplaceAll(name, "*_", "")
+		err := filepath.Walk(fmt.Sprintf("%s/%s", s.workdir, s.resp.Header().Get("Gotenberg-Trace")), func(currentPath string, info os.FileInfo, pathErr error) error {
+			if pathErr != nil {
+				return pathErr
+			}
+			if strings.Contains(info.Name(), substr) {
+				path = currentPath
+				return filepath.SkipDir
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("walk %q: %w", s.workdir, err)
+		}
+	}
+
+	cmd := []string{
+		"pdfinfo",
+		"-f",
+		fmt.Sprintf("%d", page),
+		"-l",
+		fmt.Sprintf("%d", page),
+		filepath.Base(path),
+	}
+
+	output, err := execCommandInIntegrationToolsContainer(ctx, cmd, path)
+	if err != nil {
+		return fmt.Errorf("exec %q: %w", cmd, err)
+	}
+
+	output = strings.ReplaceAll(output, " ", "")
+	re := regexp.MustCompile(`Page\d+rot:(\d+)`)
+	matches := re.FindStringSubmatch(output)
+
+	if len(matches) < 2 {
+		return errors.New("expected page rotation")
+	}
+
+	actual, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return fmt.Errorf("convert rotation value %q to integer: %w", matches[1], err)
+	}
+
+	if actual != angle {
+		return fmt.Errorf("expected page %d to be rotated by %d degree(s), but actual is %d", page, angle, actual)
+	}
+
+	return nil
+}
+
+func (s *scenario) thePdfShouldHavePageBoxAt(ctx context.Context, name string, page int, box, expected string) error {
+	var path string
+	if !strings.HasPrefix(name, "*_") {
+		path = fmt.Sprintf("%s/%s/%s", s.workdir, s.resp.Header().Get("Gotenberg-Trace"), name)
+
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("PDF %q does not exist", path)
+		}
+	} else {
+		substr := strings.ReplaceAll(name, "*_", "")
+		err := filepath.Walk(fmt.Sprintf("%s/%s", s.workdir, s.resp.Header().Get("Gotenberg-Trace")), func(currentPath string, info os.FileInfo, pathErr error) error {
+			if pathErr != nil {
+				return pathErr
+			}
+			if strings.Contains(info.Name(), substr) {
+				path = currentPath
+				return filepath.SkipDir
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("walk %q: %w", s.workdir, err)
+		}
+	}
+
+	cmd := []string{
+		"pdfinfo",
+		"-box",
+		"-f",
+		fmt.Sprintf("%d", page),
+		"-l",
+		fmt.Sprintf("%d", page),
+		filepath.Base(path),
+	}
+
+	output, err := execCommandInIntegrationToolsContainer(ctx, cmd, path)
+	if err != nil {
+		return fmt.Errorf("exec %q: %w", cmd, err)
+	}
+
+	re := regexp.MustCompile(fmt.Sprintf(`Page\s+%d\s+%s:\s+([-\d.]+)\s+([-\d.]+)\s+([-\d.]+)\s+([-\d.]+)`, page, regexp.QuoteMeta(box)))
+	matches := re.FindStringSubmatch(output)
+
+	if len(matches) < 5 {
+		return fmt.Errorf("expected page %d %s", page, box)
+	}
+
+	// Round the coordinates, as PDF engines may not write exact values.
+	actual := make([]string, 4)
+	for i, match := range matches[1:] {
+		coordinate, err := strconv.ParseFloat(match, 64)
+		if err != nil {
+			return fmt.Errorf("convert coordinate %q to float: %w", match, err)
+		}
+
+		actual[i] = strconv.Itoa(int(math.Round(coordinate)))
+	}
+
+	if strings.Join(actual, " ") != strings.Join(strings.Fields(expected), " ") {
+		return fmt.Errorf("expected page %d %s at %q, but actual is %q", page, box, expected, strings.Join(actual, " "))
+	}
+
+	return nil
+}
+
 func (s *scenario) thePdfShouldHaveTheFollowingContentAtPage(ctx context.Context, name, kind string, page int, expected *godog.DocString) error {
 	var path string
 	if !strings.HasPrefix(name, "*_") {
@@ -982,6 +1123,8 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Then(`^the "([^"]*)" PDF should have (\d+) page\(s\)$`, s.thePdfShouldHavePages)
 	ctx.Then(`^the "([^"]*)" PDF (should|should NOT) be set to landscape orientation$`, s.thePdfShouldBeSetToLandscapeOrientation)
 	ctx.Then(`^the "([^"]*)" PDF (should|should NOT) have the following content at page (\d+):$`, s.thePdfShouldHaveTheFollowingContentAtPage)
+	ctx.Then(`^the "([^"]*)" PDF should have its page (\d+) rotated by (\d+) degree\(s\)$`, s.thePdfShouldHavePageRotatedBy)
+	ctx.Then(`^the "([^"]*)" PDF should have its page (\d+) "([^"]*)" at "([^"]*)"$`, s.thePdfShouldHavePageBoxAt)
 	ctx.After(func(ctx context.Context, sc *godog.Scenario, err error) (context.Context, error) {
 		if s.gotenbergContainer != nil {
 			errTerminate := s.gotenbergContainer.Terminate(ctx, testcontainers.StopTimeout(0))